@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// shardConfigMapLock is a leader election lock which stores the leader election record of a shard in its own
+// annotation of a ConfigMap shared by all shards. The name of the ConfigMap does not depend on the number of shards,
+// so the permissions of the application controller do not either.
+type shardConfigMapLock struct {
+	ConfigMapMeta metav1.ObjectMeta
+	Client        typedcorev1.ConfigMapsGetter
+	LockConfig    resourcelock.ResourceLockConfig
+	// RecordKey is the annotation key of the leader election record of the shard
+	RecordKey string
+	cm        *corev1.ConfigMap
+}
+
+var _ resourcelock.Interface = &shardConfigMapLock{}
+
+// leaderElectionRecordKey returns the annotation key of the leader election record of the given shard. Without
+// sharding, the key of the default ConfigMap lock is used.
+func leaderElectionRecordKey(replicas, shard int) string {
+	if replicas > 1 {
+		return fmt.Sprintf("%s-%d", resourcelock.LeaderElectionRecordAnnotationKey, shard)
+	}
+	return resourcelock.LeaderElectionRecordAnnotationKey
+}
+
+// Get returns the leader election record of the shard. The record is empty if the shard has no leader yet.
+func (l *shardConfigMapLock) Get() (*resourcelock.LeaderElectionRecord, error) {
+	cm, err := l.Client.ConfigMaps(l.ConfigMapMeta.Namespace).Get(l.ConfigMapMeta.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	l.cm = cm
+	var record resourcelock.LeaderElectionRecord
+	if recordBytes, found := cm.Annotations[l.RecordKey]; found {
+		if err := json.Unmarshal([]byte(recordBytes), &record); err != nil {
+			return nil, err
+		}
+	}
+	return &record, nil
+}
+
+// Create creates the ConfigMap with the leader election record of the shard
+func (l *shardConfigMapLock) Create(ler resourcelock.LeaderElectionRecord) error {
+	recordBytes, err := json.Marshal(ler)
+	if err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        l.ConfigMapMeta.Name,
+			Namespace:   l.ConfigMapMeta.Namespace,
+			Annotations: map[string]string{l.RecordKey: string(recordBytes)},
+		},
+	}
+	l.cm, err = l.Client.ConfigMaps(l.ConfigMapMeta.Namespace).Create(cm)
+	return err
+}
+
+// Update updates the leader election record of the shard. Conflicts caused by the other shards updating their own
+// records are retried, but the update fails if the record of the shard changed since it was retrieved.
+func (l *shardConfigMapLock) Update(ler resourcelock.LeaderElectionRecord) error {
+	if l.cm == nil {
+		return fmt.Errorf("configmap not initialized, call get or create first")
+	}
+	recordBytes, err := json.Marshal(ler)
+	if err != nil {
+		return err
+	}
+	observed := l.cm.Annotations[l.RecordKey]
+	cm := l.cm.DeepCopy()
+	for {
+		if cm.Annotations == nil {
+			cm.Annotations = make(map[string]string)
+		}
+		cm.Annotations[l.RecordKey] = string(recordBytes)
+		updated, updateErr := l.Client.ConfigMaps(l.ConfigMapMeta.Namespace).Update(cm)
+		if updateErr == nil {
+			l.cm = updated
+			return nil
+		}
+		if !apierr.IsConflict(updateErr) {
+			return updateErr
+		}
+		cm, err = l.Client.ConfigMaps(l.ConfigMapMeta.Namespace).Get(l.ConfigMapMeta.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if cm.Annotations[l.RecordKey] != observed {
+			return updateErr
+		}
+	}
+}
+
+// RecordEvent records an event on the ConfigMap
+func (l *shardConfigMapLock) RecordEvent(s string) {
+	if l.LockConfig.EventRecorder == nil || l.cm == nil {
+		return
+	}
+	events := fmt.Sprintf("%v %v", l.LockConfig.Identity, s)
+	l.LockConfig.EventRecorder.Eventf(&corev1.ConfigMap{ObjectMeta: l.cm.ObjectMeta}, corev1.EventTypeNormal, "LeaderElection", events)
+}
+
+// Describe returns the ConfigMap and the annotation key of the lock
+func (l *shardConfigMapLock) Describe() string {
+	return fmt.Sprintf("%v/%v[%v]", l.ConfigMapMeta.Namespace, l.ConfigMapMeta.Name, l.RecordKey)
+}
+
+// Identity returns the identity of this replica
+func (l *shardConfigMapLock) Identity() string {
+	return l.LockConfig.Identity
+}
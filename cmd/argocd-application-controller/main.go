@@ -8,8 +8,15 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
 
 	// load the gcp plugin (required to authenticate against GKE clusters).
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -19,11 +26,14 @@ import (
 	argocd "github.com/argoproj/argo-cd"
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/controller"
+	"github.com/argoproj/argo-cd/controller/sharding"
 	"github.com/argoproj/argo-cd/errors"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/cli"
+	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/rand"
 	"github.com/argoproj/argo-cd/util/settings"
 	"github.com/argoproj/argo-cd/util/stats"
 )
@@ -33,18 +43,30 @@ const (
 	cliName = "argocd-application-controller"
 	// Default time in seconds for application resync period
 	defaultAppResyncPeriod = 180
+	// Default time in seconds to wait between self heal attempts
+	defaultSelfHealTimeoutSeconds = 5
+	// Name of the config map used as leader election lock. All shards share the config map, see shardConfigMapLock
+	leaderElectionLockName = "argocd-application-controller-leader"
 )
 
 func newCommand() *cobra.Command {
 	var (
-		clientConfig        clientcmd.ClientConfig
-		appResyncPeriod     int64
-		repoServerAddress   string
-		statusProcessors    int
-		operationProcessors int
-		logLevel            string
-		glogLevel           int
-		cacheSrc            func() (*cache.Cache, error)
+		clientConfig           clientcmd.ClientConfig
+		appResyncPeriod        int64
+		selfHealTimeoutSeconds int
+		inProcessApply         bool
+		repoServerAddress      string
+		statusProcessors       int
+		operationProcessors    int
+		logLevel               string
+		glogLevel              int
+		cacheSrc               func() (*cache.Cache, error)
+		replicas               int
+		shard                  int
+		leaderElect            bool
+		leaseDuration          time.Duration
+		renewDeadline          time.Duration
+		retryPeriod            time.Duration
 	)
 	var command = cobra.Command{
 		Use:   cliName,
@@ -73,6 +95,23 @@ func newCommand() *cobra.Command {
 			errors.CheckError(err)
 
 			settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
+			if replicas > 1 {
+				if shard < 0 {
+					shard, err = sharding.InferShard()
+					errors.CheckError(err)
+				}
+				if shard >= replicas {
+					log.Fatalf("Shard %d is out of range of %d replicas", shard, replicas)
+				}
+				log.Infof("Processing clusters of shard %d of %d replicas", shard, replicas)
+			} else {
+				shard = 0
+			}
+
+			var kubectl kube.Kubectl = kube.KubectlCmd{}
+			if inProcessApply {
+				kubectl = kube.NewInProcessKubectl()
+			}
 			appController, err := controller.NewApplicationController(
 				namespace,
 				settingsMgr,
@@ -80,7 +119,11 @@ func newCommand() *cobra.Command {
 				appClient,
 				repoClientset,
 				cache,
-				resyncDuration)
+				resyncDuration,
+				time.Duration(selfHealTimeoutSeconds)*time.Second,
+				kubectl,
+				replicas,
+				shard)
 			errors.CheckError(err)
 
 			log.Infof("Application Controller (version: %s) starting (namespace: %s)", argocd.GetVersion(), namespace)
@@ -88,7 +131,15 @@ func newCommand() *cobra.Command {
 			stats.StartStatsTicker(10 * time.Minute)
 			stats.RegisterHeapDumper("memprofile")
 
-			go appController.Run(ctx, statusProcessors, operationProcessors)
+			if leaderElect {
+				recordKey := leaderElectionRecordKey(replicas, shard)
+				lec := newLeaderElectionConfig(kubeClient, namespace, recordKey, leaseDuration, renewDeadline, retryPeriod, func(ctx context.Context) {
+					appController.Run(ctx, statusProcessors, operationProcessors)
+				})
+				go leaderelection.RunOrDie(ctx, lec)
+			} else {
+				go appController.Run(ctx, statusProcessors, operationProcessors)
+			}
 
 			// Wait forever
 			select {}
@@ -100,12 +151,62 @@ func newCommand() *cobra.Command {
 	command.Flags().StringVar(&repoServerAddress, "repo-server", common.DefaultRepoServerAddr, "Repo server address.")
 	command.Flags().IntVar(&statusProcessors, "status-processors", 1, "Number of application status processors")
 	command.Flags().IntVar(&operationProcessors, "operation-processors", 1, "Number of application operation processors")
+	command.Flags().IntVar(&selfHealTimeoutSeconds, "self-heal-timeout-seconds", defaultSelfHealTimeoutSeconds, "Specifies timeout between application self heal attempts")
+	command.Flags().BoolVar(&inProcessApply, "in-process-apply", false, "Apply resources using client-go instead of spawning kubectl processes")
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
+	command.Flags().IntVar(&replicas, "replicas", 1, "Number of application controller replicas the clusters are distributed over")
+	command.Flags().IntVar(&shard, "shard", -1, "Shard number of this application controller replica. If omitted, the shard is inferred from the hostname of the StatefulSet pod")
+	command.Flags().BoolVar(&leaderElect, "leader-elect", false, "Only run the controller if this replica was elected as leader, so that multiple replicas can be run for high availability")
+	command.Flags().DurationVar(&leaseDuration, "leader-elect-lease-duration", 15*time.Second, "Duration standby replicas wait before taking over leadership of a leader which stopped renewing it")
+	command.Flags().DurationVar(&renewDeadline, "leader-elect-renew-deadline", 10*time.Second, "Duration the leader retries renewing leadership before giving it up")
+	command.Flags().DurationVar(&retryPeriod, "leader-elect-retry-period", 2*time.Second, "Duration replicas wait between attempts to acquire or renew leadership")
 	cacheSrc = cache.AddCacheFlagsToCmd(&command)
 	return &command
 }
 
+// newLeaderElectionConfig returns a leader election config which runs the given function while this replica is the leader
+// of the shard whose leader election record is stored under the given annotation key
+func newLeaderElectionConfig(kubeClient kubernetes.Interface, namespace, recordKey string, leaseDuration, renewDeadline, retryPeriod time.Duration, run func(ctx context.Context)) leaderelection.LeaderElectionConfig {
+	hostname, err := os.Hostname()
+	errors.CheckError(err)
+	identity := fmt.Sprintf("%s_%s", hostname, rand.RandString(8))
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events(namespace)})
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: cliName})
+
+	return leaderelection.LeaderElectionConfig{
+		Lock: &shardConfigMapLock{
+			ConfigMapMeta: metav1.ObjectMeta{Namespace: namespace, Name: leaderElectionLockName},
+			Client:        kubeClient.CoreV1(),
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity:      identity,
+				EventRecorder: recorder,
+			},
+			RecordKey: recordKey,
+		},
+		LeaseDuration: leaseDuration,
+		RenewDeadline: renewDeadline,
+		RetryPeriod:   retryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Infof("Started leading as %s", identity)
+				run(ctx)
+			},
+			OnStoppedLeading: func() {
+				// Exit so that a restarted process starts with fresh informers and caches as a standby replica
+				log.Fatalf("Stopped leading as %s", identity)
+			},
+			OnNewLeader: func(leader string) {
+				if leader != identity {
+					log.Infof("Waiting as standby, current leader is %s", leader)
+				}
+			},
+		},
+	}
+}
+
 func main() {
 	if err := newCommand().Execute(); err != nil {
 		fmt.Println(err)
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+func TestLeaderElectionRecordKey(t *testing.T) {
+	assert.Equal(t, resourcelock.LeaderElectionRecordAnnotationKey, leaderElectionRecordKey(1, 0))
+	assert.Equal(t, resourcelock.LeaderElectionRecordAnnotationKey+"-0", leaderElectionRecordKey(3, 0))
+	assert.Equal(t, resourcelock.LeaderElectionRecordAnnotationKey+"-2", leaderElectionRecordKey(3, 2))
+	assert.Equal(t, resourcelock.LeaderElectionRecordAnnotationKey+"-12", leaderElectionRecordKey(20, 12))
+}
+
+func TestNewLeaderElectionConfig(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	recordKey := leaderElectionRecordKey(3, 2)
+	lec := newLeaderElectionConfig(kubeClient, "argocd", recordKey, 15*time.Second, 10*time.Second, 2*time.Second, func(ctx context.Context) {})
+
+	assert.Equal(t, 15*time.Second, lec.LeaseDuration)
+	assert.Equal(t, 10*time.Second, lec.RenewDeadline)
+	assert.Equal(t, 2*time.Second, lec.RetryPeriod)
+	lock, ok := lec.Lock.(*shardConfigMapLock)
+	if assert.True(t, ok) {
+		// the lock config map is the same for all shards
+		assert.Equal(t, leaderElectionLockName, lock.ConfigMapMeta.Name)
+		assert.Equal(t, "argocd", lock.ConfigMapMeta.Namespace)
+		assert.Equal(t, recordKey, lock.RecordKey)
+		assert.NotEmpty(t, lock.Identity())
+	}
+}
+
+func TestShardConfigMapLock(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	newLock := func(shard int, identity string) *shardConfigMapLock {
+		return &shardConfigMapLock{
+			ConfigMapMeta: metav1.ObjectMeta{Namespace: "argocd", Name: leaderElectionLockName},
+			Client:        kubeClient.CoreV1(),
+			LockConfig:    resourcelock.ResourceLockConfig{Identity: identity},
+			RecordKey:     leaderElectionRecordKey(2, shard),
+		}
+	}
+	lock0 := newLock(0, "replica-a")
+	lock1 := newLock(1, "replica-b")
+
+	// the first shard creates the config map
+	_, err := lock0.Get()
+	assert.Error(t, err)
+	assert.NoError(t, lock0.Create(resourcelock.LeaderElectionRecord{HolderIdentity: "replica-a"}))
+
+	// the second shard has no leader yet and adds its record to the existing config map
+	record, err := lock1.Get()
+	assert.NoError(t, err)
+	assert.Empty(t, record.HolderIdentity)
+	assert.NoError(t, lock1.Update(resourcelock.LeaderElectionRecord{HolderIdentity: "replica-b"}))
+
+	record, err = lock0.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "replica-a", record.HolderIdentity)
+	record, err = lock1.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "replica-b", record.HolderIdentity)
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(leaderElectionLockName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, cm.Annotations, 2)
+	assert.Equal(t, "argocd/argocd-application-controller-leader["+lock1.RecordKey+"]", lock1.Describe())
+}
@@ -73,6 +73,7 @@ func NewCommand() *cobra.Command {
 	command.AddCommand(NewImportCommand())
 	command.AddCommand(NewExportCommand())
 	command.AddCommand(NewClusterConfig())
+	command.AddCommand(NewAutoSyncCommand())
 
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
 	return command
@@ -542,6 +543,52 @@ func NewClusterConfig() *cobra.Command {
 	return command
 }
 
+// NewAutoSyncCommand returns a new instance of `argocd-util auto-sync` command
+func NewAutoSyncCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "auto-sync",
+		Short: "Globally suspend or resume automated syncs of all applications",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(newSetAutoSyncDisabledCommand("disable", "Suspend automated syncs of all applications", true))
+	command.AddCommand(newSetAutoSyncDisabledCommand("enable", "Resume automated syncs of all applications", false))
+	return command
+}
+
+func newSetAutoSyncDisabledCommand(use, desc string, disabled bool) *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+	)
+	var command = &cobra.Command{
+		Use:   use,
+		Short: desc,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conf, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			kubeclientset, err := kubernetes.NewForConfig(conf)
+			errors.CheckError(err)
+
+			settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, namespace)
+			argoCDSettings, err := settingsMgr.GetSettings()
+			errors.CheckError(err)
+			argoCDSettings.AutoSyncDisabled = disabled
+			errors.CheckError(settingsMgr.SaveSettings(argoCDSettings))
+			fmt.Printf("Automated sync %sd for all applications\n", use)
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	return command
+}
+
 func main() {
 	if err := NewCommand().Execute(); err != nil {
 		fmt.Println(err)
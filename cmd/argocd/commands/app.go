@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/controller"
 	"github.com/argoproj/argo-cd/errors"
 	"github.com/argoproj/argo-cd/pkg/apiclient"
@@ -68,6 +69,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationPendingOpsCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
@@ -209,6 +211,13 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 					printAppResources(w, app, showOperation)
 					_ = w.Flush()
 				}
+				// resources tree might not be available yet if the application has not been reconciled
+				if tree, err := appIf.ResourceTree(context.Background(), &application.ResourcesQuery{ApplicationName: &appName}); err == nil && len(tree.OrphanedNodes) > 0 {
+					fmt.Println()
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					printOrphanedResources(w, tree)
+					_ = w.Flush()
+				}
 			default:
 				log.Fatalf("Unknown output format: %s", output)
 			}
@@ -238,10 +247,22 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string) {
 		if app.Spec.SyncPolicy.Automated.Prune {
 			syncPolicy += " (Prune)"
 		}
+		if app.Spec.SyncPolicy.Automated.SelfHeal {
+			syncPolicy += " (SelfHeal)"
+		}
 	} else {
 		syncPolicy = "<none>"
 	}
 	fmt.Printf(printOpFmtStr, "Sync Policy:", syncPolicy)
+	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil && app.Spec.SyncPolicy.Schedule != nil {
+		fmt.Printf(printOpFmtStr, "Sync Schedule:", app.Spec.SyncPolicy.Schedule.Cron)
+		nextRun, err := app.Spec.SyncPolicy.Schedule.NextRun(time.Now())
+		if err != nil {
+			fmt.Printf(printOpFmtStr, "Next Sync:", err.Error())
+		} else {
+			fmt.Printf(printOpFmtStr, "Next Sync:", nextRun.Format(time.RFC3339))
+		}
+	}
 	syncStatusStr := string(app.Status.Sync.Status)
 	switch app.Status.Sync.Status {
 	case argoappv1.SyncStatusCodeSynced:
@@ -390,19 +411,30 @@ func setAppOptions(flags *pflag.FlagSet, app *argoappv1.Application, appOpts *ap
 			app.Spec.Destination.Namespace = appOpts.destNamespace
 		case "project":
 			app.Spec.Project = appOpts.project
+		case "revision-history-limit":
+			limit := appOpts.revisionHistoryLimit
+			app.Spec.RevisionHistoryLimit = &limit
 		case "nameprefix":
 			setKustomizeOpt(&app.Spec.Source, &appOpts.namePrefix)
 		case "sync-policy":
 			switch appOpts.syncPolicy {
 			case "automated":
-				app.Spec.SyncPolicy = &argoappv1.SyncPolicy{
-					Automated: &argoappv1.SyncPolicyAutomated{},
+				if app.Spec.SyncPolicy == nil {
+					app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
 				}
+				app.Spec.SyncPolicy.Automated = &argoappv1.SyncPolicyAutomated{}
 			case "none":
-				app.Spec.SyncPolicy = nil
+				if app.Spec.SyncPolicy != nil {
+					app.Spec.SyncPolicy.Automated = nil
+				}
 			default:
 				log.Fatalf("Invalid sync-policy: %s", appOpts.syncPolicy)
 			}
+		case "field-manager":
+			if app.Spec.SyncPolicy == nil {
+				app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			app.Spec.SyncPolicy.FieldManager = appOpts.fieldManager
 		}
 	})
 	if flags.Changed("auto-prune") {
@@ -411,10 +443,98 @@ func setAppOptions(flags *pflag.FlagSet, app *argoappv1.Application, appOpts *ap
 		}
 		app.Spec.SyncPolicy.Automated.Prune = appOpts.autoPrune
 	}
+	if flags.Changed("self-heal") {
+		if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+			log.Fatal("Cannot set --self-heal: application not configured with automatic sync")
+		}
+		app.Spec.SyncPolicy.Automated.SelfHeal = appOpts.selfHeal
+	}
+	if flags.Changed("auto-prune-limit") {
+		if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+			log.Fatal("Cannot set --auto-prune-limit: application not configured with automatic sync")
+		}
+		app.Spec.SyncPolicy.Automated.PruneLimit = appOpts.autoPruneLimit
+	}
+	if flags.Changed("auto-prune-percent-limit") {
+		if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+			log.Fatal("Cannot set --auto-prune-percent-limit: application not configured with automatic sync")
+		}
+		app.Spec.SyncPolicy.Automated.PrunePercentLimit = appOpts.autoPrunePercentLimit
+	}
+	if flags.Changed("retry-limit") {
+		if appOpts.retryLimit != 0 {
+			if app.Spec.SyncPolicy == nil {
+				app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			app.Spec.SyncPolicy.Retry = newRetryStrategy(appOpts.retryLimit, appOpts.retryBackoffDuration, appOpts.retryBackoffMaxDuration, appOpts.retryBackoffFactor)
+		} else if app.Spec.SyncPolicy != nil {
+			app.Spec.SyncPolicy.Retry = nil
+		}
+	}
+	if flags.Changed("wait-for-health") {
+		if appOpts.waitForHealth {
+			if app.Spec.SyncPolicy == nil {
+				app.Spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			app.Spec.SyncPolicy.HealthCheck = &argoappv1.SyncHealthCheck{Timeout: appOpts.healthTimeout}
+		} else if app.Spec.SyncPolicy != nil {
+			app.Spec.SyncPolicy.HealthCheck = nil
+		}
+	}
+	if flags.Changed("sync-schedule") {
+		if appOpts.syncSchedule != "" {
+			if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+				log.Fatal("Cannot set --sync-schedule: application not configured with automatic sync")
+			}
+			app.Spec.SyncPolicy.Schedule = &argoappv1.SyncSchedule{Cron: appOpts.syncSchedule}
+		} else if app.Spec.SyncPolicy != nil {
+			app.Spec.SyncPolicy.Schedule = nil
+		}
+	}
+	if flags.Changed("sync-schedule-timezone") || flags.Changed("sync-schedule-duration") {
+		if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Schedule == nil {
+			log.Fatal("Cannot set --sync-schedule-timezone or --sync-schedule-duration: application not configured with a sync schedule")
+		}
+		if flags.Changed("sync-schedule-timezone") {
+			app.Spec.SyncPolicy.Schedule.TimeZone = appOpts.syncScheduleTimeZone
+		}
+		if flags.Changed("sync-schedule-duration") {
+			app.Spec.SyncPolicy.Schedule.Duration = appOpts.syncScheduleDuration
+		}
+	}
+	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated == nil && app.Spec.SyncPolicy.Retry == nil && app.Spec.SyncPolicy.HealthCheck == nil && app.Spec.SyncPolicy.Schedule == nil {
+		app.Spec.SyncPolicy = nil
+	}
 
 	return visited
 }
 
+// newRetryStrategy returns a sync retry strategy from the values of the retry flags
+func newRetryStrategy(limit int64, duration string, maxDuration string, factor int64) *argoappv1.RetryStrategy {
+	return &argoappv1.RetryStrategy{
+		Limit: limit,
+		Backoff: &argoappv1.Backoff{
+			Duration:    duration,
+			MaxDuration: maxDuration,
+			Factor:      factor,
+		},
+	}
+}
+
+// addHealthCheckFlags adds the flags which configure waiting for synced resources to become healthy
+func addHealthCheckFlags(command *cobra.Command, waitForHealth *bool, timeout *string) {
+	command.Flags().BoolVar(waitForHealth, "wait-for-health", false, "Keep the sync operation running until all synced resources are healthy")
+	command.Flags().StringVar(timeout, "health-timeout", fmt.Sprintf("%v", argoappv1.DefaultSyncHealthCheckTimeout), "Fail the sync operation if the synced resources are not healthy after this duration (e.g. 2m, 1h)")
+}
+
+// addRetryFlags adds the flags which configure the retry strategy of sync operations
+func addRetryFlags(command *cobra.Command, limit *int64, duration *string, maxDuration *string, factor *int64) {
+	command.Flags().Int64Var(limit, "retry-limit", 0, "Max number of allowed sync retries (a negative value allows unlimited retries)")
+	command.Flags().StringVar(duration, "retry-backoff-duration", fmt.Sprintf("%v", argoappv1.DefaultSyncRetryDuration), "Retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
+	command.Flags().StringVar(maxDuration, "retry-backoff-max-duration", fmt.Sprintf("%v", argoappv1.DefaultSyncRetryMaxDuration), "Max retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
+	command.Flags().Int64Var(factor, "retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed retry")
+}
+
 func setKsonnetOpt(src *argoappv1.ApplicationSource, env *string) {
 	if src.Ksonnet == nil {
 		src.Ksonnet = &argoappv1.ApplicationSourceKsonnet{}
@@ -452,20 +572,34 @@ func setHelmOpt(src *argoappv1.ApplicationSource, valueFiles []string) {
 }
 
 type appOptions struct {
-	repoURL                string
-	appPath                string
-	env                    string
-	revision               string
-	destServer             string
-	destNamespace          string
-	parameters             []string
-	valuesFiles            []string
-	project                string
-	syncPolicy             string
-	autoPrune              bool
-	namePrefix             string
-	directoryRecurse       bool
-	configManagementPlugin string
+	repoURL                 string
+	appPath                 string
+	env                     string
+	revision                string
+	destServer              string
+	destNamespace           string
+	parameters              []string
+	valuesFiles             []string
+	project                 string
+	syncPolicy              string
+	autoPrune               bool
+	selfHeal                bool
+	autoPruneLimit          int64
+	autoPrunePercentLimit   int64
+	retryLimit              int64
+	retryBackoffDuration    string
+	retryBackoffMaxDuration string
+	retryBackoffFactor      int64
+	waitForHealth           bool
+	healthTimeout           string
+	namePrefix              string
+	directoryRecurse        bool
+	configManagementPlugin  string
+	revisionHistoryLimit    int64
+	syncSchedule            string
+	syncScheduleTimeZone    string
+	syncScheduleDuration    string
+	fieldManager            string
 }
 
 func addAppFlags(command *cobra.Command, opts *appOptions) {
@@ -480,9 +614,19 @@ func addAppFlags(command *cobra.Command, opts *appOptions) {
 	command.Flags().StringVar(&opts.project, "project", "", "Application project name")
 	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: automated, none)")
 	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning when sync is automated")
+	command.Flags().BoolVar(&opts.selfHeal, "self-heal", false, "Set self healing when sync is automated")
+	command.Flags().Int64Var(&opts.autoPruneLimit, "auto-prune-limit", 0, "Maximum number of resources an automated sync may prune (0 means no limit)")
+	command.Flags().Int64Var(&opts.autoPrunePercentLimit, "auto-prune-percent-limit", 0, "Maximum percentage of the application's resources an automated sync may prune (0 means no limit)")
+	addRetryFlags(command, &opts.retryLimit, &opts.retryBackoffDuration, &opts.retryBackoffMaxDuration, &opts.retryBackoffFactor)
+	addHealthCheckFlags(command, &opts.waitForHealth, &opts.healthTimeout)
 	command.Flags().StringVar(&opts.namePrefix, "nameprefix", "", "Kustomize nameprefix")
 	command.Flags().BoolVar(&opts.directoryRecurse, "directory-recurse", false, "Recurse directory")
 	command.Flags().StringVar(&opts.configManagementPlugin, "config-management-plugin", "", "Config management plugin name")
+	command.Flags().Int64Var(&opts.revisionHistoryLimit, "revision-history-limit", common.RevisionHistoryLimit, "Maximum number of deployments kept in the application's history")
+	command.Flags().StringVar(&opts.syncSchedule, "sync-schedule", "", "Only perform automated syncs at the times of this cron schedule (e.g. \"0 2 * * *\"). An empty value removes the schedule")
+	command.Flags().StringVar(&opts.syncScheduleTimeZone, "sync-schedule-timezone", "", "Time zone the sync schedule is evaluated in (e.g. Europe/Berlin). Defaults to UTC")
+	command.Flags().StringVar(&opts.syncScheduleDuration, "sync-schedule-duration", "", fmt.Sprintf("Amount of time after each scheduled time during which an automated sync may start (default %v)", argoappv1.DefaultSyncScheduleDuration))
+	command.Flags().StringVar(&opts.fieldManager, "field-manager", "", fmt.Sprintf("Field manager of the resources synced with server-side apply (default %s)", common.ArgoCDFieldManager))
 }
 
 // NewApplicationUnsetCommand returns a new instance of an `argocd app unset` command
@@ -755,7 +899,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				normalizer, err := argo.NewDiffNormalizer(app.Spec.IgnoreDifferences, overrides)
 				errors.CheckError(err)
 				// Diff is already available in ResourceDiff Diff field but we have to recalculate diff again due to https://github.com/yudai/gojsondiff/issues/31
-				diffRes := diff.Diff(item.target, item.live, normalizer)
+				diffRes := diff.DiffWithFieldManager(item.target, item.live, normalizer, app.GetFieldManager())
 				if diffRes.Modified || item.target == nil || item.live == nil {
 					fmt.Printf("===== %s/%s %s/%s ======\n", item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name)
 					var live *unstructured.Unstructured
@@ -825,7 +969,8 @@ func printDiff(name string, live *unstructured.Unstructured, target *unstructure
 // NewApplicationDeleteCommand returns a new instance of an `argocd app delete` command
 func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		cascade bool
+		cascade           bool
+		propagationPolicy string
 	)
 	var command = &cobra.Command{
 		Use:   "delete APPNAME",
@@ -844,12 +989,16 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				if c.Flag("cascade").Changed {
 					appDeleteReq.Cascade = &cascade
 				}
+				if c.Flag("propagation-policy").Changed {
+					appDeleteReq.PropagationPolicy = &propagationPolicy
+				}
 				_, err := appIf.Delete(context.Background(), &appDeleteReq)
 				errors.CheckError(err)
 			}
 		},
 	}
 	command.Flags().BoolVar(&cascade, "cascade", true, "Perform a cascaded deletion of all application resources")
+	command.Flags().StringVarP(&propagationPolicy, "propagation-policy", "p", "foreground", "Specify propagation policy for deletion of application's resources. One of: foreground|background|orphan")
 	return command
 }
 
@@ -907,6 +1056,9 @@ func formatSyncPolicy(app argoappv1.Application) string {
 	if app.Spec.SyncPolicy.Automated.Prune {
 		policy = policy + "-Prune"
 	}
+	if app.Spec.SyncPolicy.Automated.SelfHeal {
+		policy = policy + "-SelfHeal"
+	}
 	return policy
 }
 
@@ -1014,24 +1166,52 @@ func printAppResources(w io.Writer, app *argoappv1.Application, showOperation bo
 	}
 	if showOperation && syncRes != nil {
 		for _, res := range syncRes.Resources {
-			if res.HookType == argoappv1.HookTypeSync || res.HookType == argoappv1.HookTypePostSync {
+			if res.HookType == argoappv1.HookTypeSync || res.HookType == argoappv1.HookTypePostSync || res.HookType == argoappv1.HookTypeSyncFail {
 				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", res.Group, res.Kind, res.Namespace, res.Name, res.HookPhase, "", res.HookType, res.Message)
 			}
 		}
 
 	}
+	if showOperation && app.Status.DeletionHooksState != nil {
+		for _, res := range app.Status.DeletionHooksState.Resources {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", res.Group, res.Kind, res.Namespace, res.Name, res.HookPhase, "", res.HookType, res.Message)
+		}
+	}
+}
+
+// printOrphanedResources prints the top level orphaned resources of the application destination namespace
+func printOrphanedResources(w io.Writer, tree *argoappv1.ApplicationTree) {
+	fmt.Fprintf(w, "ORPHANED RESOURCES:\n")
+	fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\n")
+	for _, node := range tree.OrphanedNodes {
+		if len(node.ParentRefs) == 0 {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", node.Group, node.Kind, node.Namespace, node.Name)
+		}
+	}
 }
 
 // NewApplicationSyncCommand returns a new instance of an `argocd app sync` command
 func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		revision  string
-		resources *[]string
-		prune     bool
-		dryRun    bool
-		timeout   uint
-		strategy  string
-		force     bool
+		revision         string
+		resources        *[]string
+		prune            bool
+		dryRun           bool
+		timeout          uint
+		strategy         string
+		force            bool
+		serverSide       bool
+		fieldManager     string
+		operationTimeout string
+		queue            bool
+
+		retryLimit              int64
+		retryBackoffDuration    string
+		retryBackoffMaxDuration string
+		retryBackoffFactor      int64
+
+		waitForHealth bool
+		healthTimeout string
 	)
 	const (
 		resourceFieldDelimiter = ":"
@@ -1072,21 +1252,39 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				Resources: syncResources,
 				Prune:     prune,
 			}
+			applyOpts := argoappv1.SyncStrategyApply{
+				Force:           force,
+				ServerSideApply: serverSide,
+				FieldManager:    fieldManager,
+			}
 			switch strategy {
 			case "apply":
-				syncReq.Strategy = &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}}
-				syncReq.Strategy.Apply.Force = force
+				syncReq.Strategy = &argoappv1.SyncStrategy{Apply: &applyOpts}
 			case "", "hook":
-				syncReq.Strategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{}}
-				syncReq.Strategy.Hook.Force = force
+				syncReq.Strategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{SyncStrategyApply: applyOpts}}
 			default:
 				log.Fatalf("Unknown sync strategy: '%s'", strategy)
 			}
+			if retryLimit != 0 {
+				syncReq.RetryStrategy = newRetryStrategy(retryLimit, retryBackoffDuration, retryBackoffMaxDuration, retryBackoffFactor)
+			}
+			if waitForHealth {
+				syncReq.HealthCheck = &argoappv1.SyncHealthCheck{Timeout: healthTimeout}
+			}
+			syncReq.Timeout = operationTimeout
+			syncReq.Queue = queue
 			ctx := context.Background()
-			_, err := appIf.Sync(ctx, &syncReq)
+			app, err := appIf.Sync(ctx, &syncReq)
 			errors.CheckError(err)
+			if len(app.PendingOperations) > 0 {
+				// the queued sync is always the last pending operation
+				queued := app.PendingOperations[len(app.PendingOperations)-1]
+				fmt.Printf("Another operation is in progress, sync of application '%s' was queued as %s\n\n", appName, queued.ID)
+				printPendingOperations(app.PendingOperations)
+				errors.CheckError(waitOnQueuedOperation(acdClient, appName, queued, timeout))
+			}
 
-			app, err := waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, syncResources)
+			app, err = waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, syncResources)
 			errors.CheckError(err)
 
 			pruningRequired := 0
@@ -1111,6 +1309,12 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
+	command.Flags().BoolVar(&serverSide, "server-side", false, "Use server-side apply instead of kubectl apply")
+	command.Flags().StringVar(&fieldManager, "field-manager", "", "Field manager used for server-side apply. Defaults to the field manager of the application")
+	command.Flags().BoolVar(&queue, "queue", false, "Queue the sync if another operation is in progress, instead of failing")
+	command.Flags().StringVar(&operationTimeout, "operation-timeout", "", "Terminate the operation if it runs longer than this duration (e.g. 30m). Overrides the default configured in argocd-cm")
+	addRetryFlags(command, &retryLimit, &retryBackoffDuration, &retryBackoffMaxDuration, &retryBackoffFactor)
+	addHealthCheckFlags(command, &waitForHealth, &healthTimeout)
 	return command
 }
 
@@ -1408,60 +1612,109 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName})
 			errors.CheckError(err)
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintf(w, "ID\tDATE\tREVISION\n")
+			fmt.Fprintf(w, "ID\tDATE\tREVISION\tINITIATED BY\n")
 			for _, depInfo := range app.Status.History {
 				rev := depInfo.Source.TargetRevision
 				if len(depInfo.Revision) >= 7 {
 					rev = fmt.Sprintf("%s (%s)", rev, depInfo.Revision[0:7])
 				}
-				fmt.Fprintf(w, "%d\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, rev)
+				fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, rev, depInfo.InitiatedBy)
 			}
 			_ = w.Flush()
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide")
+	command.AddCommand(NewApplicationHistoryDiffCommand(clientOpts))
+	return command
+}
+
+// NewApplicationHistoryDiffCommand returns a new instance of an `argocd app history diff` command
+func NewApplicationHistoryDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	shortDesc := "Perform a diff between the manifests of two deployments of the application history."
+	var command = &cobra.Command{
+		Use:   "diff APPNAME ID1 ID2",
+		Short: shortDesc,
+		Long:  shortDesc + "\nUses 'diff' to render the difference. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(2)
+			}
+			appName := args[0]
+			fromID, err := strconv.ParseInt(args[1], 10, 64)
+			errors.CheckError(err)
+			toID, err := strconv.ParseInt(args[2], 10, 64)
+			errors.CheckError(err)
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			res, err := appIf.HistoryDiff(context.Background(), &application.ApplicationHistoryDiffQuery{Name: &appName, FromID: fromID, ToID: toID})
+			errors.CheckError(err)
+
+			foundDiffs := false
+			for _, item := range res.Items {
+				from, err := item.LiveObject()
+				errors.CheckError(err)
+				to, err := item.TargetObject()
+				errors.CheckError(err)
+				if from != nil && to != nil && !diff.Diff(to, from, nil).Modified {
+					continue
+				}
+				fmt.Printf("===== %s/%s %s/%s ======\n", item.Group, item.Kind, item.Namespace, item.Name)
+				foundDiffs = true
+				printDiff(item.Name, from, to)
+			}
+			if foundDiffs {
+				os.Exit(1)
+			}
+		},
+	}
 	return command
 }
 
 // NewApplicationRollbackCommand returns a new instance of an `argocd app rollback` command
 func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		prune   bool
-		timeout uint
+		prune    bool
+		timeout  uint
+		revision string
 	)
 	var command = &cobra.Command{
-		Use:   "rollback APPNAME ID",
-		Short: "Rollback application to a previous deployed version by History ID",
+		Use:   "rollback APPNAME [ID]",
+		Short: "Rollback application to a previous deployed version by History ID, or to a commit of the tracked branch",
 		Run: func(c *cobra.Command, args []string) {
-			if len(args) != 2 {
+			if len(args) == 0 || (revision == "" && len(args) != 2) || (revision != "" && len(args) != 1) {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
 			appName := args[0]
-			depID, err := strconv.Atoi(args[1])
-			errors.CheckError(err)
 			acdClient := argocdclient.NewClientOrDie(clientOpts)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer util.Close(conn)
 			ctx := context.Background()
-			app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName})
-			errors.CheckError(err)
-			var depInfo *argoappv1.RevisionHistory
-			for _, di := range app.Status.History {
-				if di.ID == int64(depID) {
-					depInfo = &di
-					break
-				}
+			rollbackReq := application.ApplicationRollbackRequest{
+				Name:     &appName,
+				Prune:    prune,
+				Revision: revision,
 			}
-			if depInfo == nil {
-				log.Fatalf("Application '%s' does not have deployment id '%d' in history\n", app.ObjectMeta.Name, depID)
+			if revision == "" {
+				depID, err := strconv.Atoi(args[1])
+				errors.CheckError(err)
+				app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName})
+				errors.CheckError(err)
+				var depInfo *argoappv1.RevisionHistory
+				for _, di := range app.Status.History {
+					if di.ID == int64(depID) {
+						depInfo = &di
+						break
+					}
+				}
+				if depInfo == nil {
+					log.Fatalf("Application '%s' does not have deployment id '%d' in history\n", app.ObjectMeta.Name, depID)
+				}
+				rollbackReq.ID = int64(depID)
 			}
 
-			_, err = appIf.Rollback(ctx, &application.ApplicationRollbackRequest{
-				Name:  &appName,
-				ID:    int64(depID),
-				Prune: prune,
-			})
+			_, err := appIf.Rollback(ctx, &rollbackReq)
 			errors.CheckError(err)
 
 			_, err = waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, nil)
@@ -1470,6 +1723,7 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 	}
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
+	command.Flags().StringVar(&revision, "revision", "", "Commit of the tracked branch to roll back to, instead of a History ID")
 	return command
 }
 
@@ -1484,6 +1738,9 @@ func printOperationResult(opState *argoappv1.OperationState) {
 		fmt.Printf(printOpFmtStr, "Operation:", "Sync")
 		fmt.Printf(printOpFmtStr, "Sync Revision:", opState.SyncResult.Revision)
 	}
+	if initiatedBy := opState.Operation.InitiatedBy.String(); initiatedBy != "" {
+		fmt.Printf(printOpFmtStr, "Initiated By:", initiatedBy)
+	}
 	fmt.Printf(printOpFmtStr, "Phase:", opState.Phase)
 	fmt.Printf(printOpFmtStr, "Start:", opState.StartedAt)
 	fmt.Printf(printOpFmtStr, "Finished:", opState.FinishedAt)
@@ -1494,6 +1751,9 @@ func printOperationResult(opState *argoappv1.OperationState) {
 		duration = time.Second * time.Duration(time.Now().UTC().Unix()-opState.StartedAt.Unix())
 	}
 	fmt.Printf(printOpFmtStr, "Duration:", duration)
+	if opState.RetryCount > 0 {
+		fmt.Printf(printOpFmtStr, "Retry Count:", fmt.Sprintf("%d", opState.RetryCount))
+	}
 	if opState.Message != "" {
 		fmt.Printf(printOpFmtStr, "Message:", opState.Message)
 	}
@@ -1583,6 +1843,101 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 	return command
 }
 
+// NewApplicationPendingOpsCommand returns a new instance of an `argocd app pending-ops` command
+func NewApplicationPendingOpsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "pending-ops APPNAME",
+		Short: "List queued operations of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName})
+			errors.CheckError(err)
+			printPendingOperations(app.PendingOperations)
+		},
+	}
+	command.AddCommand(NewApplicationCancelPendingOpCommand(clientOpts))
+	return command
+}
+
+// NewApplicationCancelPendingOpCommand returns a new instance of an `argocd app pending-ops cancel` command
+func NewApplicationCancelPendingOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "cancel APPNAME ID",
+		Short: "Remove a queued operation of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			id := args[1]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			_, err := appIf.CancelPendingOperation(context.Background(), &application.PendingOperationCancelRequest{Name: &appName, ID: id})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' pending operation '%s' cancelled\n", appName, id)
+		},
+	}
+	return command
+}
+
+// waitOnQueuedOperation waits until the queued operation is started. It returns an error if the operation was
+// cancelled instead, or did not start in time.
+func waitOnQueuedOperation(acdClient apiclient.Client, appName string, queued argoappv1.PendingOperation, timeout uint) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if timeout != 0 {
+		time.AfterFunc(time.Duration(timeout)*time.Second, func() {
+			cancel()
+		})
+	}
+	for appEvent := range acdClient.WatchApplicationWithRetry(ctx, appName) {
+		pending, started := queuedOperationStatus(&appEvent.Application, queued)
+		if pending {
+			continue
+		}
+		if !started {
+			return fmt.Errorf("Queued operation %s of application '%s' was cancelled", queued.ID, appName)
+		}
+		return nil
+	}
+	return fmt.Errorf("Timed out (%ds) waiting for queued operation %s of application '%s' to start", timeout, queued.ID, appName)
+}
+
+// queuedOperationStatus returns whether the queued operation is still pending, and if not, whether it was started
+func queuedOperationStatus(app *argoappv1.Application, queued argoappv1.PendingOperation) (bool, bool) {
+	for _, op := range app.PendingOperations {
+		if op.ID == queued.ID {
+			return true, false
+		}
+	}
+	if app.Operation != nil {
+		return false, reflect.DeepEqual(app.Operation.Sync, queued.Operation.Sync)
+	}
+	// the operation may already have completed
+	state := app.Status.OperationState
+	return false, state != nil && !state.StartedAt.Before(&queued.QueuedAt) && reflect.DeepEqual(state.Operation.Sync, queued.Operation.Sync)
+}
+
+func printPendingOperations(pending []argoappv1.PendingOperation) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ID\tQUEUED AT\tREVISION\tINITIATED BY\n")
+	for _, op := range pending {
+		revision := ""
+		if op.Operation.Sync != nil {
+			revision = op.Operation.Sync.Revision
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", op.ID, op.QueuedAt, revision, op.Operation.InitiatedBy)
+	}
+	_ = w.Flush()
+}
+
 func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
 		Use:   "edit APPNAME",
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestQueuedOperationStatus(t *testing.T) {
+	queuedAt := metav1.NewTime(time.Now().Truncate(time.Second))
+	queued := argoappv1.PendingOperation{
+		ID:        "abc",
+		Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}},
+		QueuedAt:  queuedAt,
+	}
+	other := argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}}
+
+	t.Run("Pending", func(t *testing.T) {
+		app := &argoappv1.Application{Operation: &other, PendingOperations: []argoappv1.PendingOperation{queued}}
+		pending, started := queuedOperationStatus(app, queued)
+		assert.True(t, pending)
+		assert.False(t, started)
+	})
+	t.Run("Running", func(t *testing.T) {
+		app := &argoappv1.Application{Operation: &queued.Operation}
+		pending, started := queuedOperationStatus(app, queued)
+		assert.False(t, pending)
+		assert.True(t, started)
+	})
+	t.Run("Completed", func(t *testing.T) {
+		app := &argoappv1.Application{}
+		app.Status.OperationState = &argoappv1.OperationState{Operation: queued.Operation, StartedAt: queuedAt, Phase: argoappv1.OperationSucceeded}
+		pending, started := queuedOperationStatus(app, queued)
+		assert.False(t, pending)
+		assert.True(t, started)
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		app := &argoappv1.Application{}
+		app.Status.OperationState = &argoappv1.OperationState{Operation: other, StartedAt: metav1.NewTime(queuedAt.Add(-time.Minute)), Phase: argoappv1.OperationSucceeded}
+		pending, started := queuedOperationStatus(app, queued)
+		assert.False(t, pending)
+		assert.False(t, started)
+
+		app = &argoappv1.Application{Operation: &other}
+		pending, started = queuedOperationStatus(app, queued)
+		assert.False(t, pending)
+		assert.False(t, started)
+	})
+}
@@ -65,6 +65,7 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		},
 	}
 	command.AddCommand(NewProjectRoleCommand(clientOpts))
+	command.AddCommand(NewProjectWindowsCommand(clientOpts))
 	command.AddCommand(NewProjectCreateCommand(clientOpts))
 	command.AddCommand(NewProjectGetCommand(clientOpts))
 	command.AddCommand(NewProjectDeleteCommand(clientOpts))
@@ -79,6 +80,8 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectDenyClusterResourceCommand(clientOpts))
 	command.AddCommand(NewProjectAllowNamespaceResourceCommand(clientOpts))
 	command.AddCommand(NewProjectDenyNamespaceResourceCommand(clientOpts))
+	command.AddCommand(NewProjectDisableAutoSyncCommand(clientOpts))
+	command.AddCommand(NewProjectEnableAutoSyncCommand(clientOpts))
 	return command
 }
 
@@ -422,6 +425,51 @@ func NewProjectRemoveSourceCommand(clientOpts *argocdclient.ClientOptions) *cobr
 	return command
 }
 
+// NewProjectDisableAutoSyncCommand returns a new instance of an `argocd proj disable-auto-sync` command
+func NewProjectDisableAutoSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return setProjectAutoSyncDisabledCmd("disable-auto-sync PROJECT", "Suspend automated syncs of all applications in the project", clientOpts, true)
+}
+
+// NewProjectEnableAutoSyncCommand returns a new instance of an `argocd proj enable-auto-sync` command
+func NewProjectEnableAutoSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return setProjectAutoSyncDisabledCmd("enable-auto-sync PROJECT", "Resume automated syncs of applications in the project", clientOpts, false)
+}
+
+func setProjectAutoSyncDisabledCmd(use, desc string, clientOpts *argocdclient.ClientOptions, disabled bool) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: desc,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &project.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			if proj.Spec.AutoSyncDisabled == disabled {
+				fmt.Printf("Automated sync is already %s for project '%s'\n", autoSyncStatus(disabled), projName)
+				return
+			}
+			proj.Spec.AutoSyncDisabled = disabled
+			_, err = projIf.Update(context.Background(), &project.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+			fmt.Printf("Automated sync %s for project '%s'\n", autoSyncStatus(disabled), projName)
+		},
+	}
+}
+
+func autoSyncStatus(disabled bool) string {
+	if disabled {
+		return "disabled"
+	}
+	return "enabled"
+}
+
 // NewProjectDeleteCommand returns a new instance of an `argocd proj delete` command
 func NewProjectDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
@@ -517,6 +565,11 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			errors.CheckError(err)
 			fmt.Printf(printProjFmtStr, "Name:", p.Name)
 			fmt.Printf(printProjFmtStr, "Description:", p.Spec.Description)
+			autoSync := "Enabled"
+			if p.Spec.AutoSyncDisabled {
+				autoSync = "Disabled"
+			}
+			fmt.Printf(printProjFmtStr, "Automated Sync:", autoSync)
 
 			// Print destinations
 			dest0 := "<none>"
@@ -0,0 +1,179 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/errors"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/server/project"
+	"github.com/argoproj/argo-cd/util"
+)
+
+// NewProjectWindowsCommand returns a new instance of the `argocd proj windows` command
+func NewProjectWindowsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	windowsCommand := &cobra.Command{
+		Use:   "windows",
+		Short: "Manage a project's sync windows",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	windowsCommand.AddCommand(NewProjectWindowsAddCommand(clientOpts))
+	windowsCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
+	windowsCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
+	return windowsCommand
+}
+
+// NewProjectWindowsAddCommand returns a new instance of an `argocd proj windows add` command
+func NewProjectWindowsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		kind         string
+		schedule     string
+		duration     string
+		applications []string
+		namespaces   []string
+		clusters     []string
+		manualSync   bool
+		timeZone     string
+	)
+	var command = &cobra.Command{
+		Use:   "add PROJECT",
+		Short: "Add a sync window to a project",
+		Example: `# Deny syncs of all applications in the prod namespace between 10pm and 6am Berlin time
+argocd proj windows add PROJECT --kind deny --schedule "0 22 * * *" --duration 8h --timezone Europe/Berlin --namespaces prod
+
+# Only allow syncs of the guestbook application on weekday mornings, but permit manual syncs at any time
+argocd proj windows add PROJECT --kind allow --schedule "0 8 * * 1-5" --duration 3h --applications guestbook --manual-sync`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			window := v1alpha1.SyncWindow{
+				Kind:         kind,
+				Schedule:     schedule,
+				Duration:     duration,
+				Applications: applications,
+				Namespaces:   namespaces,
+				Clusters:     clusters,
+				ManualSync:   manualSync,
+				TimeZone:     timeZone,
+			}
+			errors.CheckError(window.Validate())
+			if len(applications) == 0 && len(namespaces) == 0 && len(clusters) == 0 {
+				log.Fatal("At least one of --applications, --namespaces or --clusters is required")
+			}
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &project.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+			proj.Spec.SyncWindows = append(proj.Spec.SyncWindows, &window)
+
+			_, err = projIf.Update(context.Background(), &project.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringVar(&kind, "kind", "", fmt.Sprintf("Sync window kind, either '%s' or '%s'", v1alpha1.SyncWindowKindAllow, v1alpha1.SyncWindowKindDeny))
+	command.Flags().StringVar(&schedule, "schedule", "", "Sync window schedule in cron format (e.g. \"0 22 * * *\")")
+	command.Flags().StringVar(&duration, "duration", "", "Sync window duration (e.g. 1h)")
+	command.Flags().StringSliceVar(&applications, "applications", []string{}, "Application names the window applies to (globs are supported)")
+	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Destination namespaces the window applies to (globs are supported)")
+	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Destination cluster URLs the window applies to (globs are supported)")
+	command.Flags().BoolVar(&manualSync, "manual-sync", false, "Allow manual syncs regardless of the window")
+	command.Flags().StringVar(&timeZone, "timezone", "", "IANA time zone the schedule is evaluated in (e.g. Europe/Berlin). Defaults to UTC")
+	return command
+}
+
+// NewProjectWindowsDeleteCommand returns a new instance of an `argocd proj windows delete` command
+func NewProjectWindowsDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "delete PROJECT ID",
+		Short: "Delete a sync window from a project. The ID is the index shown by `argocd proj windows list`",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			id, err := strconv.Atoi(args[1])
+			errors.CheckError(err)
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &project.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+			if id < 0 || id >= len(proj.Spec.SyncWindows) {
+				log.Fatalf("Sync window %d does not exist in project '%s'", id, projName)
+			}
+			proj.Spec.SyncWindows = append(proj.Spec.SyncWindows[:id], proj.Spec.SyncWindows[id+1:]...)
+
+			_, err = projIf.Update(context.Background(), &project.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+			fmt.Printf("Sync window %d deleted\n", id)
+		},
+	}
+	return command
+}
+
+// NewProjectWindowsListCommand returns a new instance of an `argocd proj windows list` command
+func NewProjectWindowsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "list PROJECT",
+		Short: "List all the sync windows of a project",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &project.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+			now := time.Now()
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "ID\tKIND\tSCHEDULE\tDURATION\tTIMEZONE\tACTIVE\tMANUALSYNC\tAPPLICATIONS\tNAMESPACES\tCLUSTERS\n")
+			for i, window := range proj.Spec.SyncWindows {
+				timeZone := window.TimeZone
+				if timeZone == "" {
+					timeZone = "UTC"
+				}
+				fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%t\t%t\t%s\t%s\t%s\n",
+					i,
+					window.Kind,
+					window.Schedule,
+					window.Duration,
+					timeZone,
+					window.Active(now),
+					window.ManualSync,
+					formatGlobs(window.Applications),
+					formatGlobs(window.Namespaces),
+					formatGlobs(window.Clusters),
+				)
+			}
+			_ = w.Flush()
+		},
+	}
+	return command
+}
+
+func formatGlobs(globs []string) string {
+	if len(globs) == 0 {
+		return "-"
+	}
+	return strings.Join(globs, ",")
+}
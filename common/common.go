@@ -35,9 +35,11 @@ const (
 	ArgoCDAdminUsername = "admin"
 	// ArgoCDUserAgentName is the default user-agent name used by the gRPC API client library and grpc-gateway
 	ArgoCDUserAgentName = "argocd-client"
+	// ArgoCDFieldManager is the default field manager used for server-side apply
+	ArgoCDFieldManager = "argocd-controller"
 	// AuthCookieName is the HTTP cookie name where we store our auth token
 	AuthCookieName = "argocd.token"
-	// RevisionHistoryLimit is the max number of successful sync to keep in history
+	// RevisionHistoryLimit is the default max number of successful sync to keep in history
 	RevisionHistoryLimit = 10
 	// K8sClientConfigQPS controls the QPS to be used in K8s REST client configs
 	K8sClientConfigQPS = 25
@@ -79,6 +81,10 @@ const (
 	AnnotationKeyHook = "argocd.argoproj.io/hook"
 	// AnnotationKeyHookDeletePolicy is the policy of deleting a hook
 	AnnotationKeyHookDeletePolicy = "argocd.argoproj.io/hook-delete-policy"
+	// AnnotationKeySyncWave is the sync wave of a resource or hook. Waves are applied in ascending order
+	AnnotationKeySyncWave = "argocd.argoproj.io/sync-wave"
+	// AnnotationKeySyncOptions is a comma separated list of options which control how a resource is synced (e.g. Prune=false)
+	AnnotationKeySyncOptions = "argocd.argoproj.io/sync-options"
 	// AnnotationKeyRefresh is the annotation key which indicates that app needs to be refreshed. Removed by application controller after app is refreshed.
 	// Might take values 'normal'/'hard'. Value 'hard' means manifest cache and target cluster state cache should be invalidated before refresh.
 	AnnotationKeyRefresh = "argocd.argoproj.io/refresh"
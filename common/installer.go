@@ -18,6 +18,8 @@ const (
 	ArgoCDManagerServiceAccount     = "argocd-manager"
 	ArgoCDManagerClusterRole        = "argocd-manager-role"
 	ArgoCDManagerClusterRoleBinding = "argocd-manager-role-binding"
+	ArgoCDManagerRole               = "argocd-manager-role"
+	ArgoCDManagerRoleBinding        = "argocd-manager-role-binding"
 )
 
 // ArgoCDManagerPolicyRules are the policies to give argocd-manager
@@ -33,6 +35,16 @@ var ArgoCDManagerPolicyRules = []rbacv1.PolicyRule{
 	},
 }
 
+// ArgoCDManagerNamespacePolicyRules are the namespace level policies to give argocd-manager
+// when its access is restricted to a list of namespaces
+var ArgoCDManagerNamespacePolicyRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"*"},
+		Resources: []string{"*"},
+		Verbs:     []string{"*"},
+	},
+}
+
 // CreateServiceAccount creates a service account
 func CreateServiceAccount(
 	clientset kubernetes.Interface,
@@ -135,25 +147,117 @@ func CreateClusterRoleBinding(
 	return nil
 }
 
-// InstallClusterManagerRBAC installs RBAC resources for a cluster manager to operate a cluster. Returns a token
-func InstallClusterManagerRBAC(clientset kubernetes.Interface) (string, error) {
-	const ns = "kube-system"
-
-	err := CreateServiceAccount(clientset, ArgoCDManagerServiceAccount, ns)
+// CreateRole creates a role in the given namespace
+func CreateRole(
+	clientset kubernetes.Interface,
+	roleName string,
+	namespace string,
+	rules []rbacv1.PolicyRule,
+) error {
+	role := rbacv1.Role{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "Role",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      roleName,
+			Namespace: namespace,
+		},
+		Rules: rules,
+	}
+	roleClient := clientset.RbacV1().Roles(namespace)
+	_, err := roleClient.Create(&role)
 	if err != nil {
-		return "", err
+		if !apierr.IsAlreadyExists(err) {
+			return fmt.Errorf("Failed to create Role %q in namespace %q: %v", roleName, namespace, err)
+		}
+		_, err = roleClient.Update(&role)
+		if err != nil {
+			return fmt.Errorf("Failed to update Role %q in namespace %q: %v", roleName, namespace, err)
+		}
+		log.Infof("Role %q in namespace %q updated", roleName, namespace)
+	} else {
+		log.Infof("Role %q in namespace %q created", roleName, namespace)
 	}
+	return nil
+}
 
-	err = CreateClusterRole(clientset, ArgoCDManagerClusterRole, ArgoCDManagerPolicyRules)
+// CreateRoleBinding creates a RoleBinding which binds the given role to a service account of another namespace
+func CreateRoleBinding(
+	clientset kubernetes.Interface,
+	roleBindingName,
+	serviceAccountName,
+	roleName string,
+	namespace string,
+	serviceAccountNamespace string,
+) error {
+	roleBinding := rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "RoleBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      roleBindingName,
+			Namespace: namespace,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "Role",
+			Name:     roleName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      serviceAccountName,
+				Namespace: serviceAccountNamespace,
+			},
+		},
+	}
+	_, err := clientset.RbacV1().RoleBindings(namespace).Create(&roleBinding)
 	if err != nil {
-		return "", err
+		if !apierr.IsAlreadyExists(err) {
+			return fmt.Errorf("Failed to create RoleBinding %q in namespace %q: %v", roleBindingName, namespace, err)
+		}
+		log.Infof("RoleBinding %q in namespace %q already exists", roleBindingName, namespace)
+		return nil
 	}
+	log.Infof("RoleBinding %q in namespace %q created, bound %q to %q", roleBindingName, namespace, serviceAccountName, roleName)
+	return nil
+}
 
-	err = CreateClusterRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerServiceAccount, ArgoCDManagerClusterRole, ns)
+// InstallClusterManagerRBAC installs RBAC resources for a cluster manager to operate a cluster. The service account
+// is created in the given namespace. If the list of managed namespaces is not empty then the service account is only
+// granted access to these namespaces, otherwise it is granted cluster-wide access. Returns a token
+func InstallClusterManagerRBAC(clientset kubernetes.Interface, ns string, namespaces []string) (string, error) {
+	err := CreateServiceAccount(clientset, ArgoCDManagerServiceAccount, ns)
 	if err != nil {
 		return "", err
 	}
 
+	if len(namespaces) == 0 {
+		err = CreateClusterRole(clientset, ArgoCDManagerClusterRole, ArgoCDManagerPolicyRules)
+		if err != nil {
+			return "", err
+		}
+
+		err = CreateClusterRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerServiceAccount, ArgoCDManagerClusterRole, ns)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		for _, namespace := range namespaces {
+			err = CreateRole(clientset, ArgoCDManagerRole, namespace, ArgoCDManagerNamespacePolicyRules)
+			if err != nil {
+				return "", err
+			}
+
+			err = CreateRoleBinding(clientset, ArgoCDManagerRoleBinding, ArgoCDManagerServiceAccount, ArgoCDManagerRole, namespace, ns)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
 	var serviceAccount *apiv1.ServiceAccount
 	var secretName string
 	err = wait.Poll(500*time.Millisecond, 30*time.Second, func() (bool, error) {
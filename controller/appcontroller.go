@@ -6,15 +6,19 @@ import (
 	"fmt"
 	"reflect"
 	"runtime/debug"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -25,6 +29,7 @@ import (
 	"github.com/argoproj/argo-cd/common"
 	statecache "github.com/argoproj/argo-cd/controller/cache"
 	"github.com/argoproj/argo-cd/controller/metrics"
+	"github.com/argoproj/argo-cd/controller/sharding"
 	"github.com/argoproj/argo-cd/errors"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
@@ -43,6 +48,11 @@ import (
 
 const (
 	updateOperationStateTimeout = 1 * time.Second
+	// healthCheckRequeueInterval is the interval in which operations waiting for synced resources to
+	// become healthy are reprocessed
+	healthCheckRequeueInterval = 10 * time.Second
+	// clusterInfoUpdateInterval is the interval in which the cluster cache statistics are persisted
+	clusterInfoUpdateInterval = 10 * time.Second
 )
 
 // ApplicationController is the controller for application resources.
@@ -68,6 +78,8 @@ type ApplicationController struct {
 	refreshRequestedApps      map[string]bool
 	refreshRequestedAppsMutex *sync.Mutex
 	metricsServer             *metrics.MetricsServer
+	selfHealTimeout           time.Duration
+	clusterFilter             func(cluster *appv1.Cluster) bool
 }
 
 type ApplicationControllerConfig struct {
@@ -84,13 +96,16 @@ func NewApplicationController(
 	repoClientset reposerver.Clientset,
 	argoCache *argocache.Cache,
 	appResyncPeriod time.Duration,
+	selfHealTimeout time.Duration,
+	kubectlCmd kube.Kubectl,
+	replicas int,
+	shard int,
 ) (*ApplicationController, error) {
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
 	settings, err := settingsMgr.GetSettings()
 	if err != nil {
 		return nil, err
 	}
-	kubectlCmd := kube.KubectlCmd{}
 	ctrl := ApplicationController{
 		cache:                     argoCache,
 		namespace:                 namespace,
@@ -107,10 +122,24 @@ func NewApplicationController(
 		auditLogger:               argo.NewAuditLogger(namespace, kubeClientset, "argocd-application-controller"),
 		settingsMgr:               settingsMgr,
 		settings:                  settings,
+		selfHealTimeout:           selfHealTimeout,
+	}
+	if replicas > 1 {
+		ctrl.clusterFilter = sharding.GetClusterFilter(replicas, shard)
 	}
 	appInformer, appLister := ctrl.newApplicationInformerAndLister()
 	projInformer := v1alpha1.NewAppProjectInformer(applicationClientset, namespace, appResyncPeriod, cache.Indexers{})
-	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settings, kubectlCmd, func(appName string, fullRefresh bool) {
+	projInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, new interface{}) {
+			oldProj, oldOK := old.(*appv1.AppProject)
+			newProj, newOK := new.(*appv1.AppProject)
+			if oldOK && newOK && oldProj.Spec.AutoSyncDisabled != newProj.Spec.AutoSyncDisabled {
+				log.WithField("project", newProj.Name).Infof("automated sync disabled changed: %v -> %v", oldProj.Spec.AutoSyncDisabled, newProj.Spec.AutoSyncDisabled)
+				ctrl.requestAppsRefresh(func(app *appv1.Application) bool { return app.Spec.GetProject() == newProj.Name })
+			}
+		},
+	})
+	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settings, kubectlCmd, ctrl.clusterFilter, func(appName string, fullRefresh bool) {
 		ctrl.requestAppRefresh(appName, fullRefresh)
 		ctrl.appRefreshQueue.Add(fmt.Sprintf("%s/%s", ctrl.namespace, appName))
 	})
@@ -121,7 +150,7 @@ func NewApplicationController(
 	ctrl.appStateManager = appStateManager
 	ctrl.stateCache = stateCache
 	metricsAddr := fmt.Sprintf("0.0.0.0:%d", common.PortArgoCDMetrics)
-	ctrl.metricsServer = metrics.NewMetricsServer(metricsAddr, ctrl.appLister)
+	ctrl.metricsServer = metrics.NewMetricsServer(metricsAddr, ctrl.appLister, ctrl.canProcessApp, shard)
 	return &ctrl, nil
 }
 
@@ -141,11 +170,40 @@ func (ctrl *ApplicationController) setAppManagedResources(a *appv1.Application,
 	return tree, ctrl.cache.SetAppManagedResources(a.Name, managedResources)
 }
 
+func isKnownOrphanedResourceExclusion(key kube.ResourceKey) bool {
+	if key.Namespace == "default" && key.Group == "" && key.Kind == kube.ServiceKind && key.Name == "kubernetes" {
+		return true
+	}
+	if key.Group == "" && key.Kind == kube.ServiceAccountKind && key.Name == "default" {
+		return true
+	}
+	return false
+}
+
 func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managedResources []*appv1.ResourceDiff) (*appv1.ApplicationTree, error) {
 	nodes := make([]appv1.ResourceNode, 0)
 
+	orphanedNodesMap := make(map[kube.ResourceKey]appv1.ResourceNode)
+	var orphanedResources *appv1.OrphanedResourcesMonitorSettings
+	if a.Spec.Destination.Namespace != "" {
+		proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()), ctrl.namespace)
+		if err != nil {
+			log.Warnf("Failed to get project of application '%s', skipping orphaned resources: %v", a.Name, err)
+		} else {
+			orphanedResources = proj.Spec.OrphanedResources
+		}
+	}
+	if orphanedResources != nil {
+		var err error
+		orphanedNodesMap, err = ctrl.stateCache.GetNamespaceTopLevelResources(a.Spec.Destination.Server, a.Spec.Destination.Namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for i := range managedResources {
 		managedResource := managedResources[i]
+		delete(orphanedNodesMap, kube.NewResourceKey(managedResource.Group, managedResource.Kind, managedResource.Namespace, managedResource.Name))
 		var live = &unstructured.Unstructured{}
 		err := json.Unmarshal([]byte(managedResource.LiveState), &live)
 		if err != nil {
@@ -177,7 +235,25 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 
 		}
 	}
-	return &appv1.ApplicationTree{Nodes: nodes}, nil
+	orphanedNodes := make([]appv1.ResourceNode, 0)
+	orphanedKeys := make([]kube.ResourceKey, 0, len(orphanedNodesMap))
+	for k := range orphanedNodesMap {
+		if !isKnownOrphanedResourceExclusion(k) && !orphanedResources.IsIgnored(k.Group, k.Kind, k.Name) {
+			orphanedKeys = append(orphanedKeys, k)
+		}
+	}
+	sort.Slice(orphanedKeys, func(i, j int) bool {
+		return orphanedKeys[i].String() < orphanedKeys[j].String()
+	})
+	for _, k := range orphanedKeys {
+		err := ctrl.stateCache.IterateHierarchy(a.Spec.Destination.Server, k, func(child appv1.ResourceNode) {
+			orphanedNodes = append(orphanedNodes, child)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &appv1.ApplicationTree{Nodes: nodes, OrphanedNodes: orphanedNodes}, nil
 }
 
 func (ctrl *ApplicationController) managedResources(a *appv1.Application, comparisonResult *comparisonResult) ([]*appv1.ResourceDiff, error) {
@@ -200,7 +276,7 @@ func (ctrl *ApplicationController) managedResources(a *appv1.Application, compar
 			if err != nil {
 				return nil, err
 			}
-			resDiff = *diff.Diff(target, live, comparisonResult.diffNormalizer)
+			resDiff = *diff.DiffWithFieldManager(target, live, comparisonResult.diffNormalizer, a.GetFieldManager())
 		}
 
 		if live != nil {
@@ -248,6 +324,7 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	}
 
 	go ctrl.stateCache.Run(ctx)
+	go wait.Until(ctrl.updateClustersInfo, clusterInfoUpdateInterval, ctx.Done())
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
 
 	for i := 0; i < statusProcessors; i++ {
@@ -273,6 +350,65 @@ func (ctrl *ApplicationController) requestAppRefresh(appName string, fullRefresh
 	ctrl.refreshRequestedApps[appName] = fullRefresh || ctrl.refreshRequestedApps[appName]
 }
 
+// requestAppsRefresh requests a full refresh of all applications matching the given filter
+func (ctrl *ApplicationController) requestAppsRefresh(filter func(app *appv1.Application) bool) {
+	for _, obj := range ctrl.appInformer.GetIndexer().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok || !filter(app) || !ctrl.canProcessApp(app) {
+			continue
+		}
+		ctrl.requestAppRefresh(app.Name, true)
+		ctrl.appRefreshQueue.Add(ctrl.toAppKey(app.Name))
+	}
+}
+
+// updateClustersInfo persists the cluster cache statistics and the number of applications of each cluster
+// managed by this controller, so that they can be served by the API server
+func (ctrl *ApplicationController) updateClustersInfo() {
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to list applications: %v", err)
+		return
+	}
+	appsCount := make(map[string]int64)
+	for _, a := range apps {
+		appsCount[a.Spec.Destination.Server]++
+	}
+	for server, info := range ctrl.stateCache.GetClustersInfo() {
+		info.ApplicationsCount = appsCount[server]
+		if err := ctrl.cache.SetClusterInfo(server, &info); err != nil {
+			log.Warnf("Failed to save info of cluster %s: %v", server, err)
+		}
+	}
+}
+
+// canProcessApp returns true if the application is deployed to a cluster managed by this controller shard
+func (ctrl *ApplicationController) canProcessApp(obj interface{}) bool {
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return false
+	}
+	if ctrl.clusterFilter == nil {
+		return true
+	}
+	cluster, err := ctrl.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	if err != nil {
+		if errorStatus, ok := status.FromError(err); !ok || errorStatus.Code() != codes.NotFound {
+			// The shard is unknown until the cluster can be retrieved. The application is checked
+			// again later, so that it is neither processed by a wrong replica, nor by none at all
+			log.WithField("application", app.Name).Warnf("Failed to get cluster %s: %v", app.Spec.Destination.Server, err)
+			key := ctrl.toAppKey(app.Name)
+			ctrl.appRefreshQueue.AddRateLimited(key)
+			ctrl.appOperationQueue.AddRateLimited(key)
+			return false
+		}
+		// The shard of an unknown cluster is calculated from the server URL, so that exactly one
+		// replica reports the error condition of the application
+		cluster = &appv1.Cluster{Server: app.Spec.Destination.Server}
+	}
+	return ctrl.clusterFilter(cluster)
+}
+
 func (ctrl *ApplicationController) isRefreshRequested(appName string) (bool, bool) {
 	ctrl.refreshRequestedAppsMutex.Lock()
 	defer ctrl.refreshRequestedAppsMutex.Unlock()
@@ -311,8 +447,13 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
+	if !ctrl.canProcessApp(app) {
+		return
+	}
 	if app.Operation != nil {
 		ctrl.processRequestedAppOperation(app)
+	} else if len(app.PendingOperations) > 0 && app.DeletionTimestamp == nil {
+		ctrl.startPendingOperation(app)
 	} else if app.DeletionTimestamp != nil && app.CascadedDeletion() {
 		err = ctrl.finalizeApplicationDeletion(app)
 		if err != nil {
@@ -327,6 +468,29 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 	return
 }
 
+// startPendingOperation dequeues the first pending operation of the application and starts it
+func (ctrl *ApplicationController) startPendingOperation(app *appv1.Application) {
+	logCtx := log.WithField("application", app.Name)
+	app = app.DeepCopy()
+	pending := app.PendingOperations[0]
+	app.Operation = &pending.Operation
+	app.PendingOperations = app.PendingOperations[1:]
+	// the result of the previous operation is kept until the started operation replaces it with its own state
+	_, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).Update(app)
+	if err != nil {
+		if apierr.IsConflict(err) {
+			// the application was modified in the meantime. It is processed again once the informer
+			// receives the latest version
+			logCtx.Infof("Skipping start of pending operation %s due to update conflict", pending.ID)
+		} else {
+			logCtx.Errorf("Failed to start pending operation %s: %v", pending.ID, err)
+			ctrl.appOperationQueue.AddRateLimited(ctrl.toAppKey(app.Name))
+		}
+		return
+	}
+	logCtx.Infof("Started pending operation %s", pending.ID)
+}
+
 func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Application) error {
 	logCtx := log.WithField("application", app.Name)
 	logCtx.Infof("Deleting resources")
@@ -339,17 +503,20 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		return nil
 	}
 
+	// The PreDelete hooks have to complete before any of the resources are deleted
+	if completed, err := ctrl.runDeleteHooks(app, appv1.HookTypePreDelete); !completed {
+		return err
+	}
+
 	objsMap, err := ctrl.stateCache.GetManagedLiveObjs(app, []*unstructured.Unstructured{})
 	if err != nil {
 		return err
 	}
-	objs := make([]*unstructured.Unstructured, 0)
-	for k := range objsMap {
-		objs = append(objs, objsMap[k])
-	}
+	objs := objectsForDeletion(objsMap)
+	propagationPolicy := app.DeletionPropagationPolicy()
 	err = util.RunAllAsync(len(objs), func(i int) error {
 		obj := objs[i]
-		return ctrl.stateCache.Delete(app.Spec.Destination.Server, obj)
+		return ctrl.stateCache.Delete(app.Spec.Destination.Server, obj, propagationPolicy)
 	})
 	if err != nil {
 		return err
@@ -359,10 +526,15 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	if err != nil {
 		return err
 	}
-	if len(objsMap) > 0 {
-		logCtx.Infof("%d objects remaining for deletion", len(objsMap))
+	if remaining := len(objectsForDeletion(objsMap)); remaining > 0 {
+		logCtx.Infof("%d objects remaining for deletion", remaining)
 		return nil
 	}
+
+	// The PostDelete hooks are run once all resources are gone
+	if completed, err := ctrl.runDeleteHooks(app, appv1.HookTypePostDelete); !completed {
+		return err
+	}
 	err = ctrl.cache.SetAppManagedResources(app.Name, nil)
 	if err != nil {
 		return err
@@ -387,6 +559,116 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	return nil
 }
 
+// runDeleteHooks runs the hooks of the specified deletion hook type and records their status in the
+// deletion hooks state of the application. Returns true once the deletion may proceed. Hooks which
+// failed or could not be run do not block the deletion, but are reported by a warning condition.
+func (ctrl *ApplicationController) runDeleteHooks(app *appv1.Application, hookType appv1.HookType) (bool, error) {
+	if !containsHookType(app.Status.DeletionHookTypes, hookType) {
+		// The manifests of the application had no hooks of this type when it was last compared, so
+		// there is no need to generate them
+		return true, nil
+	}
+	state := app.Status.DeletionHooksState.DeepCopy()
+	if state == nil {
+		state = &appv1.DeletionHooksState{Phase: appv1.OperationRunning, StartedAt: metav1.Now()}
+	}
+	if state.Phase.Completed() {
+		return true, nil
+	}
+
+	completed := ctrl.appStateManager.RunDeleteHooks(app, state, hookType)
+	switch {
+	case state.Phase.Completed():
+	case completed && (hookType == appv1.HookTypePostDelete || !containsHookType(app.Status.DeletionHookTypes, appv1.HookTypePostDelete)):
+		state.Phase = appv1.OperationSucceeded
+		state.Message = "successfully ran deletion hooks"
+	case completed:
+		state.Message = "deleting resources"
+	}
+	if state.Phase.Completed() {
+		now := metav1.Now()
+		state.FinishedAt = &now
+	}
+	err := ctrl.setDeletionHooksState(app, state)
+	if err != nil {
+		return false, err
+	}
+	if !state.Phase.Completed() {
+		return completed, nil
+	}
+	if state.Phase.Successful() {
+		message := fmt.Sprintf("Deletion hooks of %s succeeded", state.Revision)
+		ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationCompleted, Type: v1.EventTypeNormal}, message)
+		return true, nil
+	}
+	message := fmt.Sprintf("%s hooks failed, deleting the application regardless: %s", hookType, state.Message)
+	ctrl.setAppCondition(app, appv1.ApplicationCondition{Type: appv1.ApplicationConditionDeletionHookWarning, Message: message})
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationCompleted, Type: v1.EventTypeWarning}, message)
+	return true, nil
+}
+
+// setDeletionHooksState persists the state of the deletion hooks of an application being deleted
+func (ctrl *ApplicationController) setDeletionHooksState(app *appv1.Application, state *appv1.DeletionHooksState) error {
+	if reflect.DeepEqual(app.Status.DeletionHooksState, state) {
+		return nil
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"deletionHooksState": state,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(app.Name, types.MergePatchType, patch)
+	if err != nil {
+		return fmt.Errorf("failed to update the state of the deletion hooks: %v", err)
+	}
+	app.Status.DeletionHooksState = state
+	return nil
+}
+
+// deleteHookTypes returns the deletion hook types of the supplied hooks
+func deleteHookTypes(hooks []*unstructured.Unstructured) []appv1.HookType {
+	hookTypes := make([]appv1.HookType, 0)
+	for _, hookType := range []appv1.HookType{appv1.HookTypePreDelete, appv1.HookTypePostDelete} {
+		for _, hook := range hooks {
+			if isHookType(hook, hookType) {
+				hookTypes = append(hookTypes, hookType)
+				break
+			}
+		}
+	}
+	return hookTypes
+}
+
+func containsHookType(hookTypes []appv1.HookType, hookType appv1.HookType) bool {
+	for _, t := range hookTypes {
+		if t == hookType {
+			return true
+		}
+	}
+	return false
+}
+
+// objectsForDeletion returns the live objects which should be deleted along with the application,
+// skipping objects which opted out using the Delete=false sync option, and PostDelete hooks which
+// are run after the deletion
+func objectsForDeletion(objsMap map[kube.ResourceKey]*unstructured.Unstructured) []*unstructured.Unstructured {
+	objs := make([]*unstructured.Unstructured, 0)
+	for k := range objsMap {
+		if isHookType(objsMap[k], appv1.HookTypePostDelete) {
+			continue
+		}
+		if hasSyncOption(objsMap[k], syncOptionDisableDeletion) {
+			log.Infof("Skipping deletion of %s %s/%s: deletion disabled by %s", k.Kind, k.Namespace, k.Name, syncOptionDisableDeletion)
+			continue
+		}
+		objs = append(objs, objsMap[k])
+	}
+	return objs
+}
+
 func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condition appv1.ApplicationCondition) {
 	index := -1
 	for i, exiting := range app.Status.Conditions {
@@ -452,8 +734,91 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		ctrl.setOperationState(app, state)
 		logCtx.Infof("Initialized new operation: %v", *app.Operation)
 	}
+	timeout, err := ctrl.getOperationTimeout(state)
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = err.Error()
+		ctrl.setOperationState(app, state)
+		return
+	}
+	timedOut := false
+	if timeout > 0 && state.Phase == appv1.OperationRunning && time.Since(state.StartedAt.Time) >= timeout {
+		// The operation did not complete in time (e.g. a hook never finished). Terminate it the
+		// same way as a user requested termination, so running hooks are cleaned up.
+		logCtx.Infof("Operation exceeded timeout of %v. Terminating", timeout)
+		state.Phase = appv1.OperationTerminating
+		timedOut = true
+	}
+	if retry := getOperationRetryStrategy(state); retry != nil && state.Phase == appv1.OperationRunning && state.FinishedAt != nil {
+		// A previous attempt of the operation failed and a retry was scheduled. Automated syncs are not
+		// retried anymore once automated sync was disabled in the meantime.
+		if state.Operation.InitiatedBy.Automated {
+			if disabled, message := ctrl.autoSyncDisabled(app); disabled {
+				logCtx.Infof("Cancelling retry of automated operation: %s", message)
+				state.Phase = appv1.OperationFailed
+				state.Message = fmt.Sprintf("%s Retry cancelled: %s", state.Message, message)
+				ctrl.setOperationState(app, state)
+				return
+			}
+		}
+		// Wait for the backoff to elapse before starting the next attempt.
+		retryAt, err := retry.NextRetryAt(state.FinishedAt.Time, state.RetryCount-1)
+		if err != nil {
+			state.Phase = appv1.OperationError
+			state.Message = err.Error()
+			ctrl.setOperationState(app, state)
+			return
+		}
+		if retryAfter := retryAt.Sub(time.Now()); retryAfter > 0 {
+			logCtx.Infof("Skipping retrying in-progress operation. Attempting again at: %s", retryAt.Format(time.RFC3339))
+			ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.Name), retryAfter)
+			return
+		}
+		// Start the next attempt from scratch. Clearing FinishedAt indicates the attempt is underway.
+		logCtx.Infof("Retrying operation. Attempt #%d", state.RetryCount)
+		state.FinishedAt = nil
+		state.SyncResult = nil
+	}
+	initialPhase := state.Phase
 	ctrl.appStateManager.SyncAppState(app, state)
 
+	if timedOut && state.Phase.Completed() {
+		state.Message = fmt.Sprintf("%s: operation exceeded timeout of %v", state.Message, timeout)
+	}
+
+	if retry := getOperationRetryStrategy(state); retry != nil && initialPhase != appv1.OperationTerminating &&
+		(state.Phase == appv1.OperationFailed || state.Phase == appv1.OperationError) {
+		if retry.CanRetry(state.RetryCount) {
+			now := metav1.Now()
+			retryAt, err := retry.NextRetryAt(now.Time, state.RetryCount)
+			if err != nil {
+				state.Message = fmt.Sprintf("%s. Unable to schedule retry: %v", state.Message, err)
+			} else {
+				state.RetryCount++
+				state.Message = fmt.Sprintf("%s. Retrying attempt #%d at %s.", state.Message, state.RetryCount, retryAt.Format(time.Kitchen))
+				// Keep the operation running, but remember when the failed attempt finished so the
+				// backoff can be calculated when the operation is resumed
+				state.Phase = appv1.OperationRunning
+				state.FinishedAt = &now
+				ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.Name), retryAt.Sub(now.Time))
+			}
+		} else if retry.Limit != 0 {
+			state.Message = fmt.Sprintf("%s. Retry limit of %d attempts reached.", state.Message, retry.Limit)
+		}
+	}
+
+	if state.Phase == appv1.OperationRunning && state.SyncResult != nil && state.SyncResult.HealthCheckStartedAt != nil {
+		// The synced resources did not become healthy yet. Requeue the operation, so the health check
+		// timeout is enforced even if the resources do not change anymore.
+		ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.Name), healthCheckRequeueInterval)
+	}
+
+	if state.Phase == appv1.OperationRunning && timeout > 0 {
+		// Requeue the operation when it is due to time out, so stuck operations are detected even if
+		// nothing else triggers a reconciliation.
+		ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.Name), time.Until(state.StartedAt.Add(timeout)))
+	}
+
 	if state.Phase == appv1.OperationRunning {
 		// It's possible for an app to be terminated while we were operating on it. We do not want
 		// to clobber the Terminated state with Running. Get the latest app state to check for this.
@@ -477,6 +842,26 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	}
 }
 
+// getOperationRetryStrategy returns the retry strategy of a sync operation, if any
+func getOperationRetryStrategy(state *appv1.OperationState) *appv1.RetryStrategy {
+	if state.Operation.Sync == nil {
+		return nil
+	}
+	return state.Operation.Sync.Retry
+}
+
+// getOperationTimeout returns the timeout of an operation, falling back to the default timeout configured in argocd-cm
+func (ctrl *ApplicationController) getOperationTimeout(state *appv1.OperationState) (time.Duration, error) {
+	if state.Operation.Sync == nil {
+		return ctrl.settings.OperationTimeout, nil
+	}
+	return state.Operation.Sync.GetTimeout(ctrl.settings.OperationTimeout)
+}
+
+func (ctrl *ApplicationController) toAppKey(appName string) string {
+	return fmt.Sprintf("%s/%s", ctrl.namespace, appName)
+}
+
 func (ctrl *ApplicationController) setOperationState(app *appv1.Application, state *appv1.OperationState) {
 	util.RetryUntilSucceed(func() error {
 		if state.Phase == "" {
@@ -564,6 +949,9 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
+	if !ctrl.canProcessApp(origApp) {
+		return
+	}
 	needRefresh, refreshType, fullRefresh := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout)
 
 	if !needRefresh {
@@ -614,15 +1002,23 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	} else {
 		ctrl.normalizeApplication(origApp, app, compareResult.appSourceType)
 		conditions = append(conditions, compareResult.conditions...)
+		if compareResult.deletionHookTypes != nil {
+			// The deletion hook types are remembered, so that the manifests of applications without
+			// deletion hooks do not have to be generated when the application is deleted
+			app.Status.DeletionHookTypes = compareResult.deletionHookTypes
+		}
 	}
 	tree, err := ctrl.setAppManagedResources(app, compareResult)
 	if err != nil {
 		logCtx.Errorf("Failed to cache app resources: %v", err)
 	} else {
 		app.Status.Ingress = tree.GetIngress()
+		if orphanedCond := ctrl.getOrphanedResourcesCondition(app, tree); orphanedCond != nil {
+			conditions = append(conditions, *orphanedCond)
+		}
 	}
 
-	syncErrCond := ctrl.autoSync(app, compareResult.syncStatus)
+	syncErrCond := ctrl.autoSync(app, compareResult.syncStatus, compareResult.managedResources)
 	if syncErrCond != nil {
 		conditions = append(conditions, *syncErrCond)
 	}
@@ -638,6 +1034,28 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	return
 }
 
+// getOrphanedResourcesCondition returns a warning condition if orphaned resources monitoring is enabled for the project
+// of the application and the destination namespace contains orphaned resources
+func (ctrl *ApplicationController) getOrphanedResourcesCondition(app *appv1.Application, tree *appv1.ApplicationTree) *appv1.ApplicationCondition {
+	proj, err := argo.GetAppProject(&app.Spec, applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()), ctrl.namespace)
+	if err != nil || proj.Spec.OrphanedResources == nil || !proj.Spec.OrphanedResources.IsWarn() {
+		return nil
+	}
+	count := 0
+	for _, node := range tree.OrphanedNodes {
+		if len(node.ParentRefs) == 0 {
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionOrphanedResourceWarning,
+		Message: fmt.Sprintf("Application has %d orphaned resources", count),
+	}
+}
+
 // needRefreshAppStatus answers if application status needs to be refreshed.
 // Returns true if application never been compared, has changed or comparison result has expired.
 // Additionally returns whether full refresh was requested or not.
@@ -695,6 +1113,12 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 		} else {
 			conditions = append(conditions, specConditions...)
 		}
+		if canSync, message := proj.CanSync(app, false, time.Now()); !canSync {
+			conditions = append(conditions, appv1.ApplicationCondition{
+				Type:    appv1.ApplicationConditionSyncWindowWarning,
+				Message: message,
+			})
+		}
 	}
 
 	// List of condition types which have to be reevaluated by controller; all remaining conditions should stay as is.
@@ -705,6 +1129,10 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 		appv1.ApplicationConditionSharedResourceWarning:   true,
 		appv1.ApplicationConditionSyncError:               true,
 		appv1.ApplicationConditionRepeatedResourceWarning: true,
+		appv1.ApplicationConditionSyncWindowWarning:       true,
+		appv1.ApplicationConditionPruneLimitError:         true,
+		appv1.ApplicationConditionAutoSyncDisabledWarning: true,
+		appv1.ApplicationConditionOrphanedResourceWarning: true,
 	}
 	appConditions := make([]appv1.ApplicationCondition, 0)
 	for i := 0; i < len(app.Status.Conditions); i++ {
@@ -783,16 +1211,37 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 	}
 }
 
+// autoSyncDisabled returns whether automated syncs of the application are disabled, either globally or for its project,
+// along with a message describing why
+func (ctrl *ApplicationController) autoSyncDisabled(app *appv1.Application) (bool, string) {
+	if ctrl.settings.AutoSyncDisabled {
+		return true, "Automated sync is disabled globally"
+	}
+	proj, err := argo.GetAppProject(&app.Spec, applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()), ctrl.namespace)
+	if err == nil && proj.Spec.AutoSyncDisabled {
+		return true, fmt.Sprintf("Automated sync is disabled for project %s", proj.Name)
+	}
+	return false, ""
+}
+
 // autoSync will initiate a sync operation for an application configured with automated sync
-func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus) *appv1.ApplicationCondition {
+func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []managedResource) *appv1.ApplicationCondition {
 	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
 		return nil
 	}
 	logCtx := log.WithFields(log.Fields{"application": app.Name})
+	if ctrl.settings.AutoSyncDisabled {
+		logCtx.Infof("Skipping auto-sync: automated sync is disabled globally")
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionAutoSyncDisabledWarning, Message: "Automated sync is disabled globally"}
+	}
 	if app.Operation != nil {
 		logCtx.Infof("Skipping auto-sync: another operation is in progress")
 		return nil
 	}
+	if len(app.PendingOperations) > 0 {
+		logCtx.Infof("Skipping auto-sync: operations are pending")
+		return nil
+	}
 	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
 		logCtx.Infof("Skipping auto-sync: deletion in progress")
 		return nil
@@ -803,40 +1252,150 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		logCtx.Infof("Skipping auto-sync: application status is %s", syncStatus.Status)
 		return nil
 	}
+	proj, err := argo.GetAppProject(&app.Spec, applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()), ctrl.namespace)
+	if err != nil {
+		logCtx.Warnf("Skipping auto-sync: failed to get project: %v", err)
+		return nil
+	}
+	if proj.Spec.AutoSyncDisabled {
+		message := fmt.Sprintf("Automated sync is disabled for project %s", proj.Name)
+		logCtx.Infof("Skipping auto-sync: %s", message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionAutoSyncDisabledWarning, Message: message}
+	}
+	if canSync, message := proj.CanSync(app, false, time.Now()); !canSync {
+		logCtx.Infof("Skipping auto-sync: %s", message)
+		return nil
+	}
+	if schedule := app.Spec.SyncPolicy.Schedule; schedule != nil {
+		// Only syncs started by the schedule count as its attempts, so that neither a manual sync
+		// suppresses the next scheduled sync, nor replaces the record of the last scheduled one
+		due, err := schedule.Due(time.Now(), app.Status.LastScheduledSyncAt)
+		if err != nil {
+			logCtx.Warnf("Skipping auto-sync: %v", err)
+			return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
+		}
+		if !due {
+			logCtx.Infof("Skipping auto-sync: no scheduled sync is due")
+			return nil
+		}
+	}
 	desiredCommitSHA := syncStatus.Revision
 
 	// It is possible for manifests to remain OutOfSync even after a sync/kubectl apply (e.g.
 	// auto-sync with pruning disabled). We need to ensure that we do not keep Syncing an
 	// application in an infinite loop. To detect this, we only attempt the Sync if the revision
 	// and parameter overrides are different from our most recent sync operation.
+	selfHeal := false
 	if alreadyAttemptedSync(app, desiredCommitSHA) {
 		if app.Status.OperationState.Phase != appv1.OperationSucceeded {
 			logCtx.Warnf("Skipping auto-sync: failed previous sync attempt to %s", desiredCommitSHA)
 			message := fmt.Sprintf("Failed sync attempt to %s: %s", desiredCommitSHA, app.Status.OperationState.Message)
 			return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}
 		}
-		logCtx.Infof("Skipping auto-sync: most recent sync already to %s", desiredCommitSHA)
-		return nil
+		if !app.Spec.SyncPolicy.Automated.SelfHeal {
+			logCtx.Infof("Skipping auto-sync: most recent sync already to %s", desiredCommitSHA)
+			return nil
+		}
+		// The live state drifted from the desired state after the most recent sync. To avoid a
+		// tight loop of syncs against a resource which is constantly being modified by someone
+		// else, we wait for the self heal timeout since the last sync before syncing again.
+		if remaining := ctrl.selfHealRemainingBackoff(app); remaining > 0 {
+			logCtx.Infof("Skipping auto-sync: self heal backoff in progress, next attempt in %v", remaining)
+			ctrl.requestAppRefresh(app.Name, true)
+			ctrl.appRefreshQueue.AddAfter(ctrl.toAppKey(app.Name), remaining)
+			return nil
+		}
+		selfHeal = true
+	}
+
+	if app.Spec.SyncPolicy.Automated.Prune {
+		if exceeded, message := pruneLimitExceeded(app.Spec.SyncPolicy.Automated, resources); exceeded {
+			logCtx.Warnf("Skipping auto-sync: %s", message)
+			// Only emit the event when the guard trips for the first time, not on every refresh
+			if !hasCondition(app, appv1.ApplicationConditionPruneLimitError, message) {
+				ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonPruneLimitExceeded, Type: v1.EventTypeWarning}, message)
+			}
+			return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionPruneLimitError, Message: message}
+		}
 	}
 
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision: desiredCommitSHA,
-			Prune:    app.Spec.SyncPolicy.Automated.Prune,
+			Revision:    desiredCommitSHA,
+			Prune:       app.Spec.SyncPolicy.Automated.Prune,
+			Retry:       app.Spec.SyncPolicy.Retry,
+			HealthCheck: app.Spec.SyncPolicy.HealthCheck,
 		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
 	}
 	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
-	_, err := argo.SetAppOperation(appIf, app.Name, &op)
+	_, err = argo.SetAppOperation(appIf, app.Name, &op)
 	if err != nil {
 		logCtx.Errorf("Failed to initiate auto-sync to %s: %v", desiredCommitSHA, err)
 		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
 	}
+	if app.Spec.SyncPolicy.Schedule != nil {
+		// persisted along with the refreshed status of the application
+		now := metav1.Now()
+		app.Status.LastScheduledSyncAt = &now
+	}
 	message := fmt.Sprintf("Initiated automated sync to '%s'", desiredCommitSHA)
+	if selfHeal {
+		message = fmt.Sprintf("Initiated automated self heal sync to '%s'", desiredCommitSHA)
+	}
 	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeNormal}, message)
 	logCtx.Info(message)
 	return nil
 }
 
+// pruneLimitExceeded returns whether an automated sync would prune more resources than permitted by
+// the prune limits of the automated sync policy, along with a message describing the violation
+func pruneLimitExceeded(automated *appv1.SyncPolicyAutomated, resources []managedResource) (bool, string) {
+	if automated.PruneLimit <= 0 && automated.PrunePercentLimit <= 0 {
+		return false, ""
+	}
+	total := 0
+	toPrune := 0
+	for _, res := range resources {
+		if res.Hook {
+			continue
+		}
+		total++
+		if res.Target == nil && res.Live != nil && !hasSyncOption(res.Live, syncOptionDisablePrune) {
+			toPrune++
+		}
+	}
+	if automated.PruneLimit > 0 && int64(toPrune) > automated.PruneLimit {
+		return true, fmt.Sprintf("Automated sync would prune %d resources, which exceeds the prune limit of %d. Sync the application manually to prune them", toPrune, automated.PruneLimit)
+	}
+	if automated.PrunePercentLimit > 0 && int64(toPrune)*100 > automated.PrunePercentLimit*int64(total) {
+		return true, fmt.Sprintf("Automated sync would prune %d of %d resources, which exceeds the prune limit of %d%%. Sync the application manually to prune them", toPrune, total, automated.PrunePercentLimit)
+	}
+	return false, ""
+}
+
+func hasCondition(app *appv1.Application, conditionType appv1.ApplicationConditionType, message string) bool {
+	for _, condition := range app.Status.Conditions {
+		if condition.Type == conditionType && condition.Message == message {
+			return true
+		}
+	}
+	return false
+}
+
+// selfHealRemainingBackoff returns how long the controller has to wait before the application can
+// be self healed, i.e. the time remaining until the self heal timeout since the most recent sync elapses
+func (ctrl *ApplicationController) selfHealRemainingBackoff(app *appv1.Application) time.Duration {
+	if app.Status.OperationState == nil || app.Status.OperationState.FinishedAt == nil {
+		return 0
+	}
+	remaining := app.Status.OperationState.FinishedAt.Add(ctrl.selfHealTimeout).Sub(time.Now())
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
 // alreadyAttemptedSync returns whether or not the most recent sync was performed against the
 // commitSHA and with the same app source config which are currently set in the app
 func alreadyAttemptedSync(app *appv1.Application, commitSHA string) bool {
@@ -867,6 +1426,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 	informer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
+				if !ctrl.canProcessApp(obj) {
+					return
+				}
 				key, err := cache.MetaNamespaceKeyFunc(obj)
 				if err == nil {
 					ctrl.appRefreshQueue.Add(key)
@@ -874,6 +1436,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 			},
 			UpdateFunc: func(old, new interface{}) {
+				if !ctrl.canProcessApp(new) {
+					return
+				}
 				key, err := cache.MetaNamespaceKeyFunc(new)
 				if err != nil {
 					return
@@ -925,6 +1490,7 @@ func (ctrl *ApplicationController) watchSettings(ctx context.Context) {
 	ctrl.settingsMgr.Subscribe(updateCh)
 	prevAppLabelKey := ctrl.settings.GetAppInstanceLabelKey()
 	prevResourceExclusions := ctrl.settings.ResourceExclusions
+	prevAutoSyncDisabled := ctrl.settings.AutoSyncDisabled
 	done := false
 	for !done {
 		select {
@@ -941,6 +1507,11 @@ func (ctrl *ApplicationController) watchSettings(ctx context.Context) {
 				ctrl.stateCache.Invalidate()
 				prevResourceExclusions = newSettings.ResourceExclusions
 			}
+			if prevAutoSyncDisabled != newSettings.AutoSyncDisabled {
+				log.Infof("automated sync disabled changed: %v -> %v", prevAutoSyncDisabled, newSettings.AutoSyncDisabled)
+				ctrl.requestAppsRefresh(func(app *appv1.Application) bool { return true })
+				prevAutoSyncDisabled = newSettings.AutoSyncDisabled
+			}
 		case <-ctx.Done():
 			done = true
 		}
@@ -2,12 +2,15 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -16,6 +19,7 @@ import (
 	kubetesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo-cd/common"
 	mockstatecache "github.com/argoproj/argo-cd/controller/cache/mocks"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
@@ -24,6 +28,7 @@ import (
 	mockrepoclient "github.com/argoproj/argo-cd/reposerver/repository/mocks"
 	"github.com/argoproj/argo-cd/test"
 	utilcache "github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/settings"
 )
@@ -31,6 +36,7 @@ import (
 type fakeData struct {
 	apps             []runtime.Object
 	manifestResponse *repository.ManifestResponse
+	manifestErr      error
 	managedLiveObjs  map[kube.ResourceKey]*unstructured.Unstructured
 }
 
@@ -43,7 +49,7 @@ func newFakeController(data *fakeData) *ApplicationController {
 
 	// Mock out call to GenerateManifest
 	mockRepoClient := mockrepoclient.RepoServerServiceClient{}
-	mockRepoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(data.manifestResponse, nil)
+	mockRepoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(data.manifestResponse, data.manifestErr)
 	mockRepoClientset := mockreposerver.Clientset{}
 	mockRepoClientset.On("NewRepoServerClient").Return(&fakeCloser{}, &mockRepoClient, nil)
 
@@ -74,6 +80,10 @@ func newFakeController(data *fakeData) *ApplicationController {
 		&mockRepoClientset,
 		utilcache.NewCache(utilcache.NewInMemoryCache(1*time.Hour)),
 		time.Minute,
+		time.Second,
+		kube.KubectlCmd{},
+		1,
+		0,
 	)
 	if err != nil {
 		panic(err)
@@ -171,13 +181,14 @@ func TestAutoSync(t *testing.T) {
 		Status:   argoappv1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond := ctrl.autoSync(app, &syncStatus)
+	cond := ctrl.autoSync(app, &syncStatus, nil)
 	assert.Nil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
 	assert.NoError(t, err)
 	assert.NotNil(t, app.Operation)
 	assert.NotNil(t, app.Operation.Sync)
 	assert.False(t, app.Operation.Sync.Prune)
+	assert.True(t, app.Operation.InitiatedBy.Automated)
 }
 
 func TestSkipAutoSync(t *testing.T) {
@@ -190,7 +201,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   argoappv1.SyncStatusCodeOutOfSync,
 			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 		}
-		cond := ctrl.autoSync(app, &syncStatus)
+		cond := ctrl.autoSync(app, &syncStatus, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
 		assert.NoError(t, err)
@@ -205,7 +216,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   argoappv1.SyncStatusCodeSynced,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond := ctrl.autoSync(app, &syncStatus)
+		cond := ctrl.autoSync(app, &syncStatus, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
 		assert.NoError(t, err)
@@ -221,7 +232,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   argoappv1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond := ctrl.autoSync(app, &syncStatus)
+		cond := ctrl.autoSync(app, &syncStatus, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
 		assert.NoError(t, err)
@@ -238,7 +249,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   argoappv1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond := ctrl.autoSync(app, &syncStatus)
+		cond := ctrl.autoSync(app, &syncStatus, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
 		assert.NoError(t, err)
@@ -264,7 +275,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   argoappv1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond := ctrl.autoSync(app, &syncStatus)
+		cond := ctrl.autoSync(app, &syncStatus, nil)
 		assert.NotNil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
 		assert.NoError(t, err)
@@ -272,6 +283,164 @@ func TestSkipAutoSync(t *testing.T) {
 	}
 }
 
+// TestAutoSyncSelfHeal verifies we re-sync the same revision if self heal is enabled and the self heal backoff elapsed
+func TestAutoSyncSelfHeal(t *testing.T) {
+	// Verify we sync again the most recently synced revision if self heal is enabled
+	{
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.SelfHeal = true
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		syncStatus := argoappv1.SyncStatus{
+			Status:   argoappv1.SyncStatusCodeOutOfSync,
+			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		}
+		cond := ctrl.autoSync(app, &syncStatus, nil)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+		assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", app.Operation.Sync.Revision)
+	}
+
+	// Verify we skip self heal if the most recent sync finished within the self heal timeout
+	{
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.SelfHeal = true
+		finishedAt := metav1.Now()
+		app.Status.OperationState.FinishedAt = &finishedAt
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		ctrl.selfHealTimeout = time.Minute
+		syncStatus := argoappv1.SyncStatus{
+			Status:   argoappv1.SyncStatusCodeOutOfSync,
+			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		}
+		cond := ctrl.autoSync(app, &syncStatus, nil)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	}
+}
+
+// TestAutoSyncSchedule verifies we only auto-sync when a scheduled sync is due
+func TestAutoSyncSchedule(t *testing.T) {
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	// Verify we skip auto-sync if the schedule already started a sync after the last scheduled run
+	{
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Schedule = &argoappv1.SyncSchedule{Cron: "0 0 * * *", Duration: "24h"}
+		now := metav1.Now()
+		app.Status.LastScheduledSyncAt = &now
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, nil)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	}
+
+	// Verify a manual sync after the last scheduled run does not suppress the scheduled sync
+	{
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Schedule = &argoappv1.SyncSchedule{Cron: "0 0 * * *", Duration: "24h"}
+		lastScheduledSyncAt := metav1.NewTime(time.Now().Add(-48 * time.Hour))
+		app.Status.LastScheduledSyncAt = &lastScheduledSyncAt
+		app.Status.OperationState.StartedAt = metav1.Now()
+		app.Status.OperationState.Operation.InitiatedBy = argoappv1.OperationInitiator{Username: "admin"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, nil)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	}
+
+	// Verify a drift after an automated and then a manual sync within the same scheduled run is not synced
+	{
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Schedule = &argoappv1.SyncSchedule{Cron: "0 0 * * *", Duration: "24h"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, nil)
+		assert.Nil(t, cond)
+		assert.NotNil(t, app.Status.LastScheduledSyncAt)
+
+		// the manual sync replaces the state of the scheduled sync
+		app.Status.OperationState = &argoappv1.OperationState{
+			Operation: argoappv1.Operation{
+				Sync:        &argoappv1.SyncOperation{Revision: "cccccccccccccccccccccccccccccccccccccccc"},
+				InitiatedBy: argoappv1.OperationInitiator{Username: "admin"},
+			},
+			Phase:     argoappv1.OperationSucceeded,
+			StartedAt: metav1.Now(),
+		}
+		ctrl = newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond = ctrl.autoSync(app, &syncStatus, nil)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	}
+
+	// Verify we sync if the scheduled run is due, and record the attempt
+	{
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Schedule = &argoappv1.SyncSchedule{Cron: "0 0 * * *", Duration: "24h"}
+		lastScheduledSyncAt := metav1.NewTime(time.Now().Add(-48 * time.Hour))
+		app.Status.LastScheduledSyncAt = &lastScheduledSyncAt
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, nil)
+		assert.Nil(t, cond)
+		assert.True(t, app.Status.LastScheduledSyncAt.After(lastScheduledSyncAt.Time))
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	}
+}
+
+// TestAutoSyncDisabled verifies we skip auto-sync and return a warning condition if automated sync is disabled
+func TestAutoSyncDisabled(t *testing.T) {
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	// Verify we skip auto-sync if automated sync is disabled globally
+	{
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		ctrl.settings.AutoSyncDisabled = true
+		cond := ctrl.autoSync(app, &syncStatus, nil)
+		assert.NotNil(t, cond)
+		assert.Equal(t, argoappv1.ApplicationConditionAutoSyncDisabledWarning, cond.Type)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	}
+
+	// Verify we skip auto-sync if automated sync is disabled for the project
+	{
+		app := newFakeApp()
+		proj := argoappv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+			Spec: argoappv1.AppProjectSpec{
+				SourceRepos:      []string{"*"},
+				Destinations:     []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				AutoSyncDisabled: true,
+			},
+		}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &proj}})
+		cond := ctrl.autoSync(app, &syncStatus, nil)
+		assert.NotNil(t, cond)
+		assert.Equal(t, argoappv1.ApplicationConditionAutoSyncDisabledWarning, cond.Type)
+		assert.Equal(t, "Automated sync is disabled for project default", cond.Message)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	}
+}
+
 // TestAutoSyncIndicateError verifies we skip auto-sync and return error condition if previous sync failed
 func TestAutoSyncIndicateError(t *testing.T) {
 	app := newFakeApp()
@@ -300,7 +469,7 @@ func TestAutoSyncIndicateError(t *testing.T) {
 			Source:   *app.Spec.Source.DeepCopy(),
 		},
 	}
-	cond := ctrl.autoSync(app, &syncStatus)
+	cond := ctrl.autoSync(app, &syncStatus, nil)
 	assert.NotNil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
 	assert.NoError(t, err)
@@ -343,13 +512,216 @@ func TestAutoSyncParameterOverrides(t *testing.T) {
 			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 		},
 	}
-	cond := ctrl.autoSync(app, &syncStatus)
+	cond := ctrl.autoSync(app, &syncStatus, nil)
 	assert.Nil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
 	assert.NoError(t, err)
 	assert.NotNil(t, app.Operation)
 }
 
+// TestAutoSyncPruneLimit verifies we refuse to auto-sync when more resources would be pruned than permitted
+func TestAutoSyncPruneLimit(t *testing.T) {
+	resources := []managedResource{
+		{Target: test.NewPod(), Live: test.NewPod()},
+		{Live: test.NewService()},
+		{Live: test.NewDeployment()},
+	}
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	{
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated = &argoappv1.SyncPolicyAutomated{Prune: true, PruneLimit: 1}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, resources)
+		assert.NotNil(t, cond)
+		assert.Equal(t, argoappv1.ApplicationConditionPruneLimitError, cond.Type)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	}
+	{
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated = &argoappv1.SyncPolicyAutomated{Prune: true, PrunePercentLimit: 50}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, resources)
+		assert.NotNil(t, cond)
+		assert.Equal(t, argoappv1.ApplicationConditionPruneLimitError, cond.Type)
+	}
+	{
+		// resources which opted out of pruning do not count towards the limit
+		disabled := test.NewDeployment()
+		disabled.SetAnnotations(map[string]string{common.AnnotationKeySyncOptions: syncOptionDisablePrune})
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated = &argoappv1.SyncPolicyAutomated{Prune: true, PruneLimit: 1}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, []managedResource{resources[0], resources[1], {Live: disabled}})
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	}
+	{
+		// limits are ignored when pruning is disabled
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated = &argoappv1.SyncPolicyAutomated{PruneLimit: 1}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, resources)
+		assert.Nil(t, cond)
+	}
+}
+
+// TestProcessRequestedAppOperationRetryBackoff verifies a retried operation is not resumed before the backoff elapsed
+func TestProcessRequestedAppOperationRetryBackoff(t *testing.T) {
+	app := newFakeApp()
+	retry := &argoappv1.RetryStrategy{Limit: 2, Backoff: &argoappv1.Backoff{Duration: "1h"}}
+	app.Operation = &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{Retry: retry},
+	}
+	finishedAt := metav1.Now()
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation:  *app.Operation,
+		Phase:      argoappv1.OperationRunning,
+		Message:    "one or more objects failed to apply. Retrying attempt #1",
+		FinishedAt: &finishedAt,
+		RetryCount: 1,
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	ctrl.processRequestedAppOperation(app)
+
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+	assert.Equal(t, argoappv1.OperationRunning, app.Status.OperationState.Phase)
+	assert.Equal(t, int64(1), app.Status.OperationState.RetryCount)
+	assert.NotNil(t, app.Status.OperationState.FinishedAt)
+}
+
+// TestProcessRequestedAppOperationRetryAutoSyncDisabled verifies a failed automated sync is not retried anymore once
+// automated sync was disabled
+func TestProcessRequestedAppOperationRetryAutoSyncDisabled(t *testing.T) {
+	newRetryingApp := func() *argoappv1.Application {
+		app := newFakeApp()
+		retry := &argoappv1.RetryStrategy{Limit: 2}
+		app.Operation = &argoappv1.Operation{
+			Sync:        &argoappv1.SyncOperation{Retry: retry},
+			InitiatedBy: argoappv1.OperationInitiator{Automated: true},
+		}
+		finishedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+		app.Status.OperationState = &argoappv1.OperationState{
+			Operation:  *app.Operation,
+			Phase:      argoappv1.OperationRunning,
+			Message:    "one or more objects failed to apply. Retrying attempt #1 at 3:04PM.",
+			FinishedAt: &finishedAt,
+			RetryCount: 1,
+		}
+		return app
+	}
+
+	// Verify the retry is cancelled if automated sync is disabled globally
+	{
+		app := newRetryingApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		ctrl.settings.AutoSyncDisabled = true
+		ctrl.processRequestedAppOperation(app)
+
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+		assert.Equal(t, argoappv1.OperationFailed, app.Status.OperationState.Phase)
+		assert.Equal(t, int64(1), app.Status.OperationState.RetryCount)
+		assert.Contains(t, app.Status.OperationState.Message, "Retry cancelled: Automated sync is disabled globally")
+	}
+
+	// Verify the retry is cancelled if automated sync is disabled for the project
+	{
+		app := newRetryingApp()
+		proj := argoappv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+			Spec: argoappv1.AppProjectSpec{
+				SourceRepos:      []string{"*"},
+				Destinations:     []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				AutoSyncDisabled: true,
+			},
+		}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &proj}})
+		ctrl.processRequestedAppOperation(app)
+
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+		assert.Equal(t, argoappv1.OperationFailed, app.Status.OperationState.Phase)
+		assert.Contains(t, app.Status.OperationState.Message, "Retry cancelled: Automated sync is disabled for project default")
+	}
+
+	// Verify manual syncs are still retried
+	{
+		app := newRetryingApp()
+		app.Operation.InitiatedBy = argoappv1.OperationInitiator{Username: "admin"}
+		app.Status.OperationState.Operation.InitiatedBy = app.Operation.InitiatedBy
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		ctrl.settings.AutoSyncDisabled = true
+		ctrl.processRequestedAppOperation(app)
+
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.NotContains(t, app.Status.OperationState.Message, "Retry cancelled")
+	}
+}
+
+// TestProcessRequestedAppOperationTimeout verifies an operation running longer than its timeout is terminated
+func TestProcessRequestedAppOperationTimeout(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{Timeout: "1h"},
+	}
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation: *app.Operation,
+		Phase:     argoappv1.OperationRunning,
+		StartedAt: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+	}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app},
+		manifestResponse: &repository.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	})
+	// the operation override takes precedence over the default timeout
+	ctrl.settings.OperationTimeout = 24 * time.Hour
+	ctrl.processRequestedAppOperation(app)
+
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.OperationFailed, app.Status.OperationState.Phase)
+	assert.Equal(t, "Operation terminated: operation exceeded timeout of 1h0m0s", app.Status.OperationState.Message)
+}
+
+// TestStartPendingOperation verifies the first pending operation is started once no operation is in progress
+func TestStartPendingOperation(t *testing.T) {
+	app := newFakeApp()
+	app.PendingOperations = []argoappv1.PendingOperation{
+		{ID: "first", Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}}},
+		{ID: "second", Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}}},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	ctrl.startPendingOperation(app)
+
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+	assert.Equal(t, "aaa", app.Operation.Sync.Revision)
+	assert.Len(t, app.PendingOperations, 1)
+	assert.Equal(t, "second", app.PendingOperations[0].ID)
+	// the result of the previous operation is kept until the started operation replaces it
+	assert.NotNil(t, app.Status.OperationState)
+	assert.Equal(t, "successfully synced", app.Status.OperationState.Message)
+}
+
 // TestFinalizeAppDeletion verifies application deletion
 func TestFinalizeAppDeletion(t *testing.T) {
 	app := newFakeApp()
@@ -369,6 +741,182 @@ func TestFinalizeAppDeletion(t *testing.T) {
 	assert.False(t, patched) // Change this to assert.True when we stub out GetResourcesWithLabel/DeleteResourceWithLabel
 }
 
+// TestFinalizeAppDeletionWithoutDeleteHooks verifies the manifests of an application without deletion hooks are not
+// generated again when it is deleted
+func TestFinalizeAppDeletionWithoutDeleteHooks(t *testing.T) {
+	app := newFakeApp()
+	app.SetCascadedDeletion(true)
+	// no manifestResponse: generating the manifests would fail
+	ctrl := newFakeController(&fakeData{
+		apps:            []runtime.Object{app},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{},
+	})
+
+	err := ctrl.finalizeApplicationDeletion(app)
+	assert.NoError(t, err)
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.False(t, app.CascadedDeletion())
+	assert.Nil(t, app.Status.DeletionHooksState)
+}
+
+// TestFinalizeAppDeletionWithUnreachableRepo verifies an application with deletion hooks is deleted, and a warning
+// condition is recorded, if the manifests of the hooks cannot be generated
+func TestFinalizeAppDeletionWithUnreachableRepo(t *testing.T) {
+	app := newFakeApp()
+	app.SetCascadedDeletion(true)
+	app.Status.DeletionHookTypes = []argoappv1.HookType{argoappv1.HookTypePreDelete}
+	ctrl := newFakeController(&fakeData{
+		apps:            []runtime.Object{app},
+		manifestErr:     fmt.Errorf("repository not accessible"),
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{},
+	})
+
+	err := ctrl.finalizeApplicationDeletion(app)
+	assert.NoError(t, err)
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.False(t, app.CascadedDeletion())
+	assert.NotNil(t, app.Status.DeletionHooksState)
+	assert.Equal(t, argoappv1.OperationError, app.Status.DeletionHooksState.Phase)
+	assert.Contains(t, app.Status.DeletionHooksState.Message, "repository not accessible")
+	var cond *argoappv1.ApplicationCondition
+	for i := range app.Status.Conditions {
+		if app.Status.Conditions[i].Type == argoappv1.ApplicationConditionDeletionHookWarning {
+			cond = &app.Status.Conditions[i]
+		}
+	}
+	assert.NotNil(t, cond)
+	// the operation state of the most recent sync is kept
+	assert.Equal(t, "successfully synced", app.Status.OperationState.Message)
+}
+
+// TestCanProcessApp verifies we only process applications of clusters managed by the controller shard
+func TestCanProcessApp(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	assert.True(t, ctrl.canProcessApp(app))
+
+	ctrl.clusterFilter = func(cluster *argoappv1.Cluster) bool {
+		return cluster.Server != app.Spec.Destination.Server
+	}
+	assert.False(t, ctrl.canProcessApp(app))
+}
+
+// clusterErrorDB is an ArgoDB which fails to get any cluster
+type clusterErrorDB struct {
+	db.ArgoDB
+	err error
+}
+
+func (d *clusterErrorDB) GetCluster(ctx context.Context, server string) (*argoappv1.Cluster, error) {
+	return nil, d.err
+}
+
+// TestCanProcessAppClusterError verifies the shard of an application is calculated from the server URL if the cluster
+// does not exist, and the application is skipped and requeued if the cluster cannot be retrieved
+func TestCanProcessAppClusterError(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	ctrl.clusterFilter = func(cluster *argoappv1.Cluster) bool {
+		return cluster.Server == app.Spec.Destination.Server
+	}
+	key := ctrl.toAppKey(app.Name)
+	argoDB := ctrl.db
+
+	ctrl.db = &clusterErrorDB{ArgoDB: argoDB, err: status.Errorf(codes.NotFound, "cluster %q not found", app.Spec.Destination.Server)}
+	assert.True(t, ctrl.canProcessApp(app))
+	assert.Equal(t, 0, ctrl.appRefreshQueue.NumRequeues(key))
+
+	ctrl.db = &clusterErrorDB{ArgoDB: argoDB, err: fmt.Errorf("connection refused")}
+	assert.False(t, ctrl.canProcessApp(app))
+	assert.Equal(t, 1, ctrl.appRefreshQueue.NumRequeues(key))
+	assert.Equal(t, 1, ctrl.appOperationQueue.NumRequeues(key))
+}
+
+// TestGetResourceTreeOrphanedResources verifies unmanaged resources of the destination namespace are reported as orphaned
+func TestGetResourceTreeOrphanedResources(t *testing.T) {
+	proj := argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			OrphanedResources: &argoappv1.OrphanedResourcesMonitorSettings{
+				Ignore: []argoappv1.OrphanedResourceKey{{Kind: "ConfigMap", Name: "ignored-*"}},
+			},
+		},
+	}
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &proj}})
+
+	managedKey := kube.NewResourceKey("", "Service", test.FakeDestNamespace, "managed")
+	orphanedKey := kube.NewResourceKey("", "ConfigMap", test.FakeDestNamespace, "orphaned")
+	ignoredKey := kube.NewResourceKey("", "ConfigMap", test.FakeDestNamespace, "ignored-cm")
+	defaultSAKey := kube.NewResourceKey("", kube.ServiceAccountKind, test.FakeDestNamespace, "default")
+	topLevelResources := make(map[kube.ResourceKey]argoappv1.ResourceNode)
+	for _, k := range []kube.ResourceKey{managedKey, orphanedKey, ignoredKey, defaultSAKey} {
+		topLevelResources[k] = argoappv1.ResourceNode{ResourceRef: argoappv1.ResourceRef{Kind: k.Kind, Namespace: k.Namespace, Name: k.Name}}
+	}
+	mockStateCache := mockstatecache.LiveStateCache{}
+	mockStateCache.On("GetNamespaceTopLevelResources", app.Spec.Destination.Server, test.FakeDestNamespace).Return(topLevelResources, nil)
+	mockStateCache.On("IterateHierarchy", app.Spec.Destination.Server, orphanedKey, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(2).(func(argoappv1.ResourceNode))(topLevelResources[orphanedKey])
+	}).Return(nil)
+	ctrl.stateCache = &mockStateCache
+
+	tree, err := ctrl.getResourceTree(app, []*argoappv1.ResourceDiff{{Kind: "Service", Namespace: test.FakeDestNamespace, Name: "managed"}})
+	assert.NoError(t, err)
+	assert.Len(t, tree.OrphanedNodes, 1)
+	assert.Equal(t, "orphaned", tree.OrphanedNodes[0].Name)
+
+	cond := ctrl.getOrphanedResourcesCondition(app, tree)
+	assert.NotNil(t, cond)
+	assert.Equal(t, argoappv1.ApplicationConditionOrphanedResourceWarning, cond.Type)
+
+	warn := false
+	proj.Spec.OrphanedResources.Warn = &warn
+	ctrl = newFakeController(&fakeData{apps: []runtime.Object{app, &proj}})
+	assert.Nil(t, ctrl.getOrphanedResourcesCondition(app, tree))
+}
+
+// TestGetResourceTreeWithoutProject verifies orphaned resources are skipped if the project cannot be found
+func TestGetResourceTreeWithoutProject(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	mockStateCache := mockstatecache.LiveStateCache{}
+	ctrl.stateCache = &mockStateCache
+
+	tree, err := ctrl.getResourceTree(app, []*argoappv1.ResourceDiff{})
+	assert.NoError(t, err)
+	assert.Len(t, tree.OrphanedNodes, 0)
+	mockStateCache.AssertNotCalled(t, "GetNamespaceTopLevelResources", mock.Anything, mock.Anything)
+}
+
+func TestUpdateClustersInfo(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	mockStateCache := mockstatecache.LiveStateCache{}
+	mockStateCache.On("GetClustersInfo").Return(map[string]argoappv1.ClusterInfo{
+		app.Spec.Destination.Server: {ServerVersion: "1.14", CacheInfo: argoappv1.ClusterCacheInfo{ResourcesCount: 10, APIsCount: 5}},
+		"https://other-cluster":     {ServerVersion: "1.13"},
+	})
+	ctrl.stateCache = &mockStateCache
+
+	ctrl.updateClustersInfo()
+
+	info, err := ctrl.cache.GetClusterInfo(app.Spec.Destination.Server)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.14", info.ServerVersion)
+	assert.Equal(t, int64(10), info.CacheInfo.ResourcesCount)
+	assert.Equal(t, int64(5), info.CacheInfo.APIsCount)
+	assert.Equal(t, int64(1), info.ApplicationsCount)
+
+	info, err = ctrl.cache.GetClusterInfo("https://other-cluster")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.13", info.ServerVersion)
+	assert.Equal(t, int64(0), info.ApplicationsCount)
+}
+
 // TestNormalizeApplication verifies we normalize an application during reconciliation
 func TestNormalizeApplication(t *testing.T) {
 	defaultProj := argoappv1.AppProject{
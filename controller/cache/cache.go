@@ -2,9 +2,11 @@ package cache
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
@@ -21,14 +23,18 @@ type LiveStateCache interface {
 	IsNamespaced(server string, obj *unstructured.Unstructured) (bool, error)
 	// Executes give callback against resource specified by the key and all its children
 	IterateHierarchy(server string, key kube.ResourceKey, action func(child appv1.ResourceNode)) error
+	// Returns top level resources (resources without owner references) of the specified namespace which are not managed by any application
+	GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error)
 	// Returns state of live nodes which correspond for target nodes of specified application.
 	GetManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error)
 	// Starts watching resources of each controlled cluster.
 	Run(ctx context.Context)
 	// Deletes specified resource from cluster.
-	Delete(server string, obj *unstructured.Unstructured) error
+	Delete(server string, obj *unstructured.Unstructured, propagationPolicy metav1.DeletionPropagation) error
 	// Invalidate invalidates the entire cluster state cache
 	Invalidate()
+	// Returns information about the cache of each cluster, keyed by cluster server URL
+	GetClustersInfo() map[string]appv1.ClusterInfo
 }
 
 func GetTargetObjKey(a *appv1.Application, un *unstructured.Unstructured, isNamespaced bool) kube.ResourceKey {
@@ -42,26 +48,34 @@ func GetTargetObjKey(a *appv1.Application, un *unstructured.Unstructured, isName
 	return key
 }
 
-func NewLiveStateCache(db db.ArgoDB, appInformer cache.SharedIndexInformer, settings *settings.ArgoCDSettings, kubectl kube.Kubectl, onAppUpdated func(appName string, fullRefresh bool)) LiveStateCache {
+// NewLiveStateCache returns a live state cache which only watches clusters accepted by the given cluster filter.
+// A nil filter accepts all clusters.
+func NewLiveStateCache(db db.ArgoDB, appInformer cache.SharedIndexInformer, settings *settings.ArgoCDSettings, kubectl kube.Kubectl, clusterFilter func(cluster *appv1.Cluster) bool, onAppUpdated func(appName string, fullRefresh bool)) LiveStateCache {
 	return &liveStateCache{
-		appInformer:  appInformer,
-		db:           db,
-		clusters:     make(map[string]*clusterInfo),
-		lock:         &sync.Mutex{},
-		onAppUpdated: onAppUpdated,
-		kubectl:      kubectl,
-		settings:     settings,
+		appInformer:   appInformer,
+		db:            db,
+		clusters:      make(map[string]*clusterInfo),
+		lock:          &sync.Mutex{},
+		onAppUpdated:  onAppUpdated,
+		kubectl:       kubectl,
+		settings:      settings,
+		clusterFilter: clusterFilter,
 	}
 }
 
 type liveStateCache struct {
-	db           db.ArgoDB
-	clusters     map[string]*clusterInfo
-	lock         *sync.Mutex
-	appInformer  cache.SharedIndexInformer
-	onAppUpdated func(appName string, fullRefresh bool)
-	kubectl      kube.Kubectl
-	settings     *settings.ArgoCDSettings
+	db            db.ArgoDB
+	clusters      map[string]*clusterInfo
+	lock          *sync.Mutex
+	appInformer   cache.SharedIndexInformer
+	onAppUpdated  func(appName string, fullRefresh bool)
+	kubectl       kube.Kubectl
+	settings      *settings.ArgoCDSettings
+	clusterFilter func(cluster *appv1.Cluster) bool
+}
+
+func (c *liveStateCache) isClusterManaged(cluster *appv1.Cluster) bool {
+	return c.clusterFilter == nil || c.clusterFilter(cluster)
 }
 
 func (c *liveStateCache) getCluster(server string) (*clusterInfo, error) {
@@ -73,6 +87,9 @@ func (c *liveStateCache) getCluster(server string) (*clusterInfo, error) {
 		if err != nil {
 			return nil, err
 		}
+		if !c.isClusterManaged(cluster) {
+			return nil, fmt.Errorf("cluster %s is not managed by this controller shard", server)
+		}
 		info = &clusterInfo{
 			apisMeta:     make(map[schema.GroupKind]*apiMeta),
 			lock:         &sync.Mutex{},
@@ -109,19 +126,17 @@ func (c *liveStateCache) Invalidate() {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	for _, clust := range c.clusters {
-		clust.lock.Lock()
 		clust.invalidate()
-		clust.lock.Unlock()
 	}
 	log.Info("live state cache invalidated")
 }
 
-func (c *liveStateCache) Delete(server string, obj *unstructured.Unstructured) error {
+func (c *liveStateCache) Delete(server string, obj *unstructured.Unstructured, propagationPolicy metav1.DeletionPropagation) error {
 	clusterInfo, err := c.getSyncedCluster(server)
 	if err != nil {
 		return err
 	}
-	return clusterInfo.delete(obj)
+	return clusterInfo.delete(obj, propagationPolicy)
 }
 
 func (c *liveStateCache) IsNamespaced(server string, obj *unstructured.Unstructured) (bool, error) {
@@ -141,6 +156,14 @@ func (c *liveStateCache) IterateHierarchy(server string, key kube.ResourceKey, a
 	return nil
 }
 
+func (c *liveStateCache) GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getNamespaceTopLevelResources(namespace), nil
+}
+
 func (c *liveStateCache) GetManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
 	clusterInfo, err := c.getSyncedCluster(a.Spec.Destination.Server)
 	if err != nil {
@@ -149,6 +172,21 @@ func (c *liveStateCache) GetManagedLiveObjs(a *appv1.Application, targetObjs []*
 	return clusterInfo.getManagedLiveObjs(a, targetObjs)
 }
 
+func (c *liveStateCache) GetClustersInfo() map[string]appv1.ClusterInfo {
+	c.lock.Lock()
+	clusters := make([]*clusterInfo, 0, len(c.clusters))
+	for _, cluster := range c.clusters {
+		clusters = append(clusters, cluster)
+	}
+	c.lock.Unlock()
+
+	res := make(map[string]appv1.ClusterInfo)
+	for _, cluster := range clusters {
+		res[cluster.cluster.Server] = cluster.getClusterInfo()
+	}
+	return res
+}
+
 func isClusterHasApps(apps []interface{}, cluster *appv1.Cluster) bool {
 	for _, obj := range apps {
 		if app, ok := obj.(*appv1.Application); ok && app.Spec.Destination.Server == cluster.Server {
@@ -165,14 +203,14 @@ func (c *liveStateCache) Run(ctx context.Context) {
 			c.lock.Lock()
 			defer c.lock.Unlock()
 			if cluster, ok := c.clusters[event.Cluster.Server]; ok {
-				if event.Type == watch.Deleted {
+				if event.Type == watch.Deleted || !c.isClusterManaged(event.Cluster) {
 					cluster.invalidate()
 					delete(c.clusters, event.Cluster.Server)
 				} else if event.Type == watch.Modified {
 					cluster.cluster = event.Cluster
 					cluster.invalidate()
 				}
-			} else if event.Type == watch.Added && isClusterHasApps(c.appInformer.GetStore().List(), event.Cluster) {
+			} else if event.Type == watch.Added && c.isClusterManaged(event.Cluster) && isClusterHasApps(c.appInformer.GetStore().List(), event.Cluster) {
 				go func() {
 					// warm up cache for cluster with apps
 					_, _ = c.getSyncedCluster(event.Cluster.Server)
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
@@ -28,9 +29,8 @@ const (
 )
 
 type apiMeta struct {
-	namespaced      bool
-	resourceVersion string
-	watchCancel     context.CancelFunc
+	namespaced  bool
+	watchCancel context.CancelFunc
 }
 
 type clusterInfo struct {
@@ -43,6 +43,8 @@ type clusterInfo struct {
 	nodes   map[kube.ResourceKey]*node
 	nsIndex map[string]map[kube.ResourceKey]*node
 
+	serverVersion string
+
 	onAppUpdated func(appName string, fullRefresh bool)
 	kubectl      kube.Kubectl
 	cluster      *appv1.Cluster
@@ -50,10 +52,12 @@ type clusterInfo struct {
 	settings     *settings.ArgoCDSettings
 }
 
-func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, resourceVersion string, objs []unstructured.Unstructured) {
+// replaceResourceCache replaces cached resources of the given kind with the given objects. If namespace is not empty
+// then only resources of the specified namespace are replaced.
+func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, objs []unstructured.Unstructured, namespace string) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	info, ok := c.apisMeta[gk]
+	_, ok := c.apisMeta[gk]
 	if ok {
 		objByKind := make(map[kube.ResourceKey]*unstructured.Unstructured)
 		for i := range objs {
@@ -68,7 +72,7 @@ func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, resourceVersion
 		}
 
 		for key, existingNode := range c.nodes {
-			if key.Kind != gk.Kind || key.Group != gk.Group {
+			if key.Kind != gk.Kind || key.Group != gk.Group || namespace != "" && key.Namespace != namespace {
 				continue
 			}
 
@@ -76,7 +80,6 @@ func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, resourceVersion
 				c.onNodeRemoved(key, existingNode)
 			}
 		}
-		info.resourceVersion = resourceVersion
 	}
 }
 
@@ -133,6 +136,8 @@ func (c *clusterInfo) removeNode(key kube.ResourceKey) {
 func (c *clusterInfo) invalidate() {
 	c.syncLock.Lock()
 	defer c.syncLock.Unlock()
+	c.lock.Lock()
+	defer c.lock.Unlock()
 	c.syncTime = nil
 	for i := range c.apisMeta {
 		c.apisMeta[i].watchCancel()
@@ -155,8 +160,10 @@ func (c *clusterInfo) stopWatching(gk schema.GroupKind) {
 	defer c.syncLock.Unlock()
 	if info, ok := c.apisMeta[gk]; ok {
 		info.watchCancel()
+		c.lock.Lock()
 		delete(c.apisMeta, gk)
-		c.replaceResourceCache(gk, "", []unstructured.Unstructured{})
+		c.lock.Unlock()
+		c.replaceResourceCache(gk, []unstructured.Unstructured{}, "")
 		log.Warnf("Stop watching %s not found on %s.", gk, c.cluster.Server)
 	}
 }
@@ -173,9 +180,34 @@ func (c *clusterInfo) startMissingWatches() error {
 		api := apis[i]
 		if _, ok := c.apisMeta[api.GroupKind]; !ok {
 			ctx, cancel := context.WithCancel(context.Background())
-			info := &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel}
-			c.apisMeta[api.GroupKind] = info
-			go c.watchEvents(ctx, api, info)
+			c.lock.Lock()
+			c.apisMeta[api.GroupKind] = &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel}
+			c.lock.Unlock()
+			err = c.processApi(api, func(resClient dynamic.ResourceInterface, namespace string) error {
+				go c.watchEvents(ctx, api, resClient, namespace)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// processApi executes the given callback against the resource interface of each namespace accessible in the cluster.
+// If the cluster namespaces are not restricted then the callback is executed once against the cluster-wide interface,
+// otherwise cluster level resources are skipped.
+func (c *clusterInfo) processApi(api kube.APIResourceInfo, callback func(resClient dynamic.ResourceInterface, namespace string) error) error {
+	if len(c.cluster.Namespaces) == 0 {
+		return callback(api.Interface, "")
+	}
+	if !api.Meta.Namespaced {
+		return nil
+	}
+	for _, namespace := range c.cluster.Namespaces {
+		if err := callback(api.Interface.Namespace(namespace), namespace); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -187,7 +219,8 @@ func runSynced(lock *sync.Mutex, action func() error) error {
 	return action()
 }
 
-func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo, info *apiMeta) {
+func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo, resClient dynamic.ResourceInterface, namespace string) {
+	resourceVersion := ""
 	util.RetryUntilSucceed(func() (err error) {
 		defer func() {
 			if r := recover(); r != nil {
@@ -196,12 +229,13 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 		}()
 
 		err = runSynced(c.syncLock, func() error {
-			if info.resourceVersion == "" {
-				list, err := api.Interface.List(metav1.ListOptions{})
+			if resourceVersion == "" {
+				list, err := resClient.List(metav1.ListOptions{})
 				if err != nil {
 					return err
 				}
-				c.replaceResourceCache(api.GroupKind, list.GetResourceVersion(), list.Items)
+				c.replaceResourceCache(api.GroupKind, list.Items, namespace)
+				resourceVersion = list.GetResourceVersion()
 			}
 			return nil
 		})
@@ -210,7 +244,7 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 			return err
 		}
 
-		w, err := api.Interface.Watch(metav1.ListOptions{ResourceVersion: info.resourceVersion})
+		w, err := resClient.Watch(metav1.ListOptions{ResourceVersion: resourceVersion})
 		if errors.IsNotFound(err) {
 			c.stopWatching(api.GroupKind)
 			return nil
@@ -218,7 +252,7 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 
 		err = runSynced(c.syncLock, func() error {
 			if errors.IsGone(err) {
-				info.resourceVersion = ""
+				resourceVersion = ""
 				log.Warnf("Resource version of %s on %s is too old.", api.GroupKind, c.cluster.Server)
 			}
 			return err
@@ -235,7 +269,7 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 			case event, ok := <-w.ResultChan():
 				if ok {
 					obj := event.Object.(*unstructured.Unstructured)
-					info.resourceVersion = obj.GetResourceVersion()
+					resourceVersion = obj.GetResourceVersion()
 					err = c.processEvent(event.Type, obj)
 					if err != nil {
 						log.Warnf("Failed to process event %s %s/%s/%s: %v", event.Type, obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName(), err)
@@ -277,27 +311,40 @@ func (c *clusterInfo) sync() (err error) {
 	for i := range c.apisMeta {
 		c.apisMeta[i].watchCancel()
 	}
+	// the cache statistics are read under the lock, see getClusterInfo
+	c.lock.Lock()
 	c.apisMeta = make(map[schema.GroupKind]*apiMeta)
 	c.nodes = make(map[kube.ResourceKey]*node)
+	c.nsIndex = make(map[string]map[kube.ResourceKey]*node)
+	c.lock.Unlock()
 
+	// the server version is informational only, so failing to get it must not prevent caching the cluster resources
+	version, err := c.kubectl.GetServerVersion(c.cluster.RESTConfig())
+	if err != nil {
+		c.log.Warnf("Failed to get server version: %v", err)
+	}
+	c.lock.Lock()
+	c.serverVersion = version
+	c.lock.Unlock()
 	apis, err := c.kubectl.GetAPIResources(c.cluster.RESTConfig(), c.settings)
 	if err != nil {
 		return err
 	}
-	lock := sync.Mutex{}
 	err = util.RunAllAsync(len(apis), func(i int) error {
 		api := apis[i]
-		list, err := api.Interface.List(metav1.ListOptions{})
-		if err != nil {
-			return err
-		}
+		return c.processApi(api, func(resClient dynamic.ResourceInterface, _ string) error {
+			list, err := resClient.List(metav1.ListOptions{})
+			if err != nil {
+				return err
+			}
 
-		lock.Lock()
-		for i := range list.Items {
-			c.setNode(createObjInfo(&list.Items[i], c.settings.GetAppInstanceLabelKey()))
-		}
-		lock.Unlock()
-		return nil
+			c.lock.Lock()
+			for i := range list.Items {
+				c.setNode(createObjInfo(&list.Items[i], c.settings.GetAppInstanceLabelKey()))
+			}
+			c.lock.Unlock()
+			return nil
+		})
 	})
 
 	if err == nil {
@@ -325,9 +372,32 @@ func (c *clusterInfo) ensureSynced() error {
 
 	err := c.sync()
 	syncTime := time.Now()
+	c.lock.Lock()
 	c.syncTime = &syncTime
 	c.syncError = err
-	return c.syncError
+	c.lock.Unlock()
+	return err
+}
+
+// getClusterInfo returns the Kubernetes version of the cluster and statistics of the cluster cache
+func (c *clusterInfo) getClusterInfo() appv1.ClusterInfo {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	info := appv1.ClusterInfo{
+		ServerVersion: c.serverVersion,
+		CacheInfo: appv1.ClusterCacheInfo{
+			ResourcesCount: int64(len(c.nodes)),
+			APIsCount:      int64(len(c.apisMeta)),
+		},
+	}
+	if c.syncTime != nil {
+		syncTime := metav1.NewTime(*c.syncTime)
+		info.CacheInfo.LastCacheSyncTime = &syncTime
+	}
+	if c.syncError != nil {
+		info.CacheInfo.SyncError = c.syncError.Error()
+	}
+	return info
 }
 
 func (c *clusterInfo) iterateHierarchy(key kube.ResourceKey, action func(child appv1.ResourceNode)) {
@@ -345,6 +415,18 @@ func (c *clusterInfo) iterateHierarchy(key kube.ResourceKey, action func(child a
 	}
 }
 
+func (c *clusterInfo) getNamespaceTopLevelResources(namespace string) map[kube.ResourceKey]appv1.ResourceNode {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	nodes := make(map[kube.ResourceKey]appv1.ResourceNode)
+	for _, node := range c.nsIndex[namespace] {
+		if len(node.ownerRefs) == 0 && node.appName == "" {
+			nodes[node.resourceKey()] = node.asResourceNode()
+		}
+	}
+	return nodes
+}
+
 func (c *clusterInfo) isNamespaced(obj *unstructured.Unstructured) bool {
 	if api, ok := c.apisMeta[kube.GetResourceKey(obj).GroupKind()]; ok && !api.namespaced {
 		return false
@@ -409,8 +491,8 @@ func (c *clusterInfo) getManagedLiveObjs(a *appv1.Application, targetObjs []*uns
 	return managedObjs, nil
 }
 
-func (c *clusterInfo) delete(obj *unstructured.Unstructured) error {
-	err := c.kubectl.DeleteResource(c.cluster.RESTConfig(), obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), false)
+func (c *clusterInfo) delete(obj *unstructured.Unstructured, propagationPolicy metav1.DeletionPropagation) error {
+	err := c.kubectl.DeleteResource(c.cluster.RESTConfig(), obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), kube.NewDeleteOptions(propagationPolicy))
 	if err != nil && errors.IsNotFound(err) {
 		// a delete request came in for an object which does not exist. it's possible that our cache
 		// is stale. Check and invalidate if it is
@@ -423,12 +505,13 @@ func (c *clusterInfo) delete(obj *unstructured.Unstructured) error {
 }
 
 // checkAndInvalidateStaleCache checks if our cache is stale and invalidate it based on error
-// should be called whenever we suspect our cache is stale
+// should be called whenever we suspect our cache is stale. Must be called with the lock held, the cache is
+// invalidated once the lock is released, since invalidate acquires the sync lock before the lock
 func (c *clusterInfo) checkAndInvalidateStaleCache(gvk schema.GroupVersionKind, namespace string, name string) {
 	if _, ok := c.nodes[kube.NewResourceKey(gvk.Group, gvk.Kind, namespace, name)]; ok {
 		if c.syncTime != nil {
 			c.log.Warnf("invalidated stale cache due to mismatch of %s, %s/%s", gvk, namespace, name)
-			c.invalidate()
+			go c.invalidate()
 		}
 	}
 }
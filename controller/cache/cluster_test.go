@@ -6,6 +6,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic/fake"
@@ -231,6 +232,89 @@ metadata:
 	})
 }
 
+func TestGetNamespaceTopLevelResources(t *testing.T) {
+	orphanedDeploy := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: orphaned-deploy
+    namespace: default
+    resourceVersion: "123"`)
+	cluster := newCluster(testPod, testRS, testDeploy, orphanedDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	resources := cluster.getNamespaceTopLevelResources("default")
+	assert.Len(t, resources, 1)
+	node, ok := resources[kube.NewResourceKey("apps", "Deployment", "default", "orphaned-deploy")]
+	assert.True(t, ok)
+	assert.Equal(t, "orphaned-deploy", node.Name)
+
+	assert.Empty(t, cluster.getNamespaceTopLevelResources("kube-system"))
+}
+
+func TestSyncNamespacedCluster(t *testing.T) {
+	otherNsDeploy := testDeploy.DeepCopy()
+	otherNsDeploy.SetNamespace("other")
+	cluster := newCluster(testPod, testRS, testDeploy, otherNsDeploy)
+	cluster.cluster.Namespaces = []string{"other"}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	_, ok := cluster.nodes[kube.GetResourceKey(otherNsDeploy)]
+	assert.True(t, ok)
+	_, ok = cluster.nodes[kube.GetResourceKey(testDeploy)]
+	assert.False(t, ok)
+	assert.Len(t, cluster.nodes, 1)
+}
+
+func TestGetClusterInfo(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.kubectl = kubetest.MockKubectlCmd{APIResources: cluster.kubectl.(kubetest.MockKubectlCmd).APIResources, Version: "1.14"}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	info := cluster.getClusterInfo()
+	assert.Equal(t, "1.14", info.ServerVersion)
+	assert.Equal(t, int64(3), info.CacheInfo.ResourcesCount)
+	assert.Equal(t, int64(3), info.CacheInfo.APIsCount)
+	assert.NotNil(t, info.CacheInfo.LastCacheSyncTime)
+	assert.Empty(t, info.CacheInfo.SyncError)
+}
+
+func TestGetClusterInfoServerVersionError(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	cluster.kubectl = kubetest.MockKubectlCmd{APIResources: cluster.kubectl.(kubetest.MockKubectlCmd).APIResources, VersionError: fmt.Errorf("unauthorized")}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	// the server version is informational only, so the error is not reported as a cache sync error
+	info := cluster.getClusterInfo()
+	assert.Empty(t, info.ServerVersion)
+	assert.Equal(t, int64(3), info.CacheInfo.ResourcesCount)
+	assert.Empty(t, info.CacheInfo.SyncError)
+}
+
+func TestGetClusterInfoDuringSync(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	// a running sync must not block reading the statistics
+	cluster.syncLock.Lock()
+	defer cluster.syncLock.Unlock()
+	done := make(chan appv1.ClusterInfo)
+	go func() {
+		done <- cluster.getClusterInfo()
+	}()
+	select {
+	case info := <-done:
+		assert.Equal(t, int64(3), info.CacheInfo.ResourcesCount)
+	case <-time.After(5 * time.Second):
+		t.Fatal("getClusterInfo blocked on the sync lock")
+	}
+}
+
 func TestChildDeletedEvent(t *testing.T) {
 	cluster := newCluster(testPod, testRS, testDeploy)
 	err := cluster.ensureSynced()
@@ -400,7 +484,7 @@ func TestWatchCacheUpdated(t *testing.T) {
 
 	podGroupKind := testPod.GroupVersionKind().GroupKind()
 
-	cluster.replaceResourceCache(podGroupKind, "updated-list-version", []unstructured.Unstructured{*updated, *added})
+	cluster.replaceResourceCache(podGroupKind, []unstructured.Unstructured{*updated, *added}, "")
 
 	_, ok := cluster.nodes[kube.GetResourceKey(removed)]
 	assert.False(t, ok)
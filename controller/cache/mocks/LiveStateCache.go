@@ -6,6 +6,7 @@ import context "context"
 import kube "github.com/argoproj/argo-cd/util/kube"
 import mock "github.com/stretchr/testify/mock"
 import unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+import v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 import v1alpha1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 
 // LiveStateCache is an autogenerated mock type for the LiveStateCache type
@@ -13,13 +14,13 @@ type LiveStateCache struct {
 	mock.Mock
 }
 
-// Delete provides a mock function with given fields: server, obj
-func (_m *LiveStateCache) Delete(server string, obj *unstructured.Unstructured) error {
-	ret := _m.Called(server, obj)
+// Delete provides a mock function with given fields: server, obj, propagationPolicy
+func (_m *LiveStateCache) Delete(server string, obj *unstructured.Unstructured, propagationPolicy v1.DeletionPropagation) error {
+	ret := _m.Called(server, obj, propagationPolicy)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, *unstructured.Unstructured) error); ok {
-		r0 = rf(server, obj)
+	if rf, ok := ret.Get(0).(func(string, *unstructured.Unstructured, v1.DeletionPropagation) error); ok {
+		r0 = rf(server, obj, propagationPolicy)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -27,6 +28,22 @@ func (_m *LiveStateCache) Delete(server string, obj *unstructured.Unstructured)
 	return r0
 }
 
+// GetClustersInfo provides a mock function with given fields:
+func (_m *LiveStateCache) GetClustersInfo() map[string]v1alpha1.ClusterInfo {
+	ret := _m.Called()
+
+	var r0 map[string]v1alpha1.ClusterInfo
+	if rf, ok := ret.Get(0).(func() map[string]v1alpha1.ClusterInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]v1alpha1.ClusterInfo)
+		}
+	}
+
+	return r0
+}
+
 // GetManagedLiveObjs provides a mock function with given fields: a, targetObjs
 func (_m *LiveStateCache) GetManagedLiveObjs(a *v1alpha1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
 	ret := _m.Called(a, targetObjs)
@@ -50,6 +67,29 @@ func (_m *LiveStateCache) GetManagedLiveObjs(a *v1alpha1.Application, targetObjs
 	return r0, r1
 }
 
+// GetNamespaceTopLevelResources provides a mock function with given fields: server, namespace
+func (_m *LiveStateCache) GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]v1alpha1.ResourceNode, error) {
+	ret := _m.Called(server, namespace)
+
+	var r0 map[kube.ResourceKey]v1alpha1.ResourceNode
+	if rf, ok := ret.Get(0).(func(string, string) map[kube.ResourceKey]v1alpha1.ResourceNode); ok {
+		r0 = rf(server, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[kube.ResourceKey]v1alpha1.ResourceNode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(server, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Invalidate provides a mock function with given fields:
 func (_m *LiveStateCache) Invalidate() {
 	_m.Called()
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"fmt"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+)
+
+// RunDeleteHooks runs the PreDelete or PostDelete hooks of the application and records their status
+// in the supplied deletion hooks state. Returns true once all hooks of the type completed successfully.
+func (m *appStateManager) RunDeleteHooks(app *appv1.Application, state *appv1.DeletionHooksState, hookType appv1.HookType) bool {
+	// The hooks are taken from the revision the deletion started with, so that all phases of the
+	// deletion run the hooks of the same revision
+	compareResult, err := m.CompareAppState(app, state.Revision, app.Spec.Source, false)
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = err.Error()
+		return false
+	}
+	if errConditions := getErrorConditions(compareResult.conditions); len(errConditions) > 0 {
+		state.Phase = appv1.OperationError
+		state.Message = argo.FormatAppConditions(errConditions)
+		return false
+	}
+	state.Revision = compareResult.syncStatus.Revision
+
+	// The hooks are run by a sync context, which records their status in an operation state
+	opState := &appv1.OperationState{
+		Phase:     state.Phase,
+		Message:   state.Message,
+		StartedAt: state.StartedAt,
+		SyncResult: &appv1.SyncOperationResult{
+			Revision:  state.Revision,
+			Source:    app.Spec.Source,
+			Resources: state.Resources,
+		},
+	}
+	syncCtx, err := m.newSyncContext(app, compareResult, &appv1.SyncOperation{Revision: state.Revision}, nil, opState)
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = err.Error()
+		return false
+	}
+	completed := syncCtx.runDeleteHooks(hookType)
+	state.Phase = opState.Phase
+	state.Message = opState.Message
+	state.Resources = opState.SyncResult.Resources
+	return completed
+}
+
+// runDeleteHooks runs the hooks of the specified deletion hook type, wave by wave. This method is
+// invoked on every reconciliation of an application which is being deleted and should be idempotent.
+func (sc *syncContext) runDeleteHooks(hookType appv1.HookType) bool {
+	hooks, err := sc.getHooks(hookType)
+	if err != nil {
+		sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to generate hooks resources: %v", err))
+		return false
+	}
+	if len(hooks) == 0 {
+		return true
+	}
+	if !sc.verifyPermittedHooks(hooks) {
+		if !sc.opState.Phase.Completed() {
+			sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("one or more %s hooks are not permitted", hookType))
+		}
+		return false
+	}
+	return sc.runWaves(nil, hooks, hookType, false)
+}
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -33,7 +34,7 @@ var (
 	descAppInfo = prometheus.NewDesc(
 		"argocd_app_info",
 		"Information about application.",
-		append(descAppDefaultLabels, "repo", "dest_server", "dest_namespace"),
+		append(descAppDefaultLabels, "repo", "dest_server", "dest_namespace", "shard"),
 		nil,
 	)
 	descAppCreated = prometheus.NewDesc(
@@ -56,10 +57,11 @@ var (
 	)
 )
 
-// NewMetricsServer returns a new prometheus server which collects application metrics
-func NewMetricsServer(addr string, appLister applister.ApplicationLister) *MetricsServer {
+// NewMetricsServer returns a new prometheus server which collects metrics of the applications
+// accepted by the given filter and processed by the given controller shard
+func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFilter func(obj interface{}) bool, shard int) *MetricsServer {
 	mux := http.NewServeMux()
-	appRegistry := NewAppRegistry(appLister)
+	appRegistry := NewAppRegistry(appLister, appFilter, shard)
 	appRegistry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
 	appRegistry.MustRegister(prometheus.NewGoCollector())
 	mux.Handle(MetricsPath, promhttp.HandlerFor(appRegistry, promhttp.HandlerOpts{}))
@@ -109,20 +111,24 @@ func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.D
 }
 
 type appCollector struct {
-	store applister.ApplicationLister
+	store     applister.ApplicationLister
+	appFilter func(obj interface{}) bool
+	shard     string
 }
 
 // NewAppCollector returns a prometheus collector for application metrics
-func NewAppCollector(appLister applister.ApplicationLister) prometheus.Collector {
+func NewAppCollector(appLister applister.ApplicationLister, appFilter func(obj interface{}) bool, shard int) prometheus.Collector {
 	return &appCollector{
-		store: appLister,
+		store:     appLister,
+		appFilter: appFilter,
+		shard:     strconv.Itoa(shard),
 	}
 }
 
 // NewAppRegistry creates a new prometheus registry that collects applications
-func NewAppRegistry(appLister applister.ApplicationLister) *prometheus.Registry {
+func NewAppRegistry(appLister applister.ApplicationLister, appFilter func(obj interface{}) bool, shard int) *prometheus.Registry {
 	registry := prometheus.NewRegistry()
-	registry.MustRegister(NewAppCollector(appLister))
+	registry.MustRegister(NewAppCollector(appLister, appFilter, shard))
 	return registry
 }
 
@@ -142,7 +148,10 @@ func (c *appCollector) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 	for _, app := range apps {
-		collectApps(ch, app)
+		if c.appFilter != nil && !c.appFilter(app) {
+			continue
+		}
+		collectApps(ch, app, c.shard)
 	}
 }
 
@@ -153,7 +162,7 @@ func boolFloat64(b bool) float64 {
 	return 0
 }
 
-func collectApps(ch chan<- prometheus.Metric, app *argoappv1.Application) {
+func collectApps(ch chan<- prometheus.Metric, app *argoappv1.Application, shard string) {
 	addConstMetric := func(desc *prometheus.Desc, t prometheus.ValueType, v float64, lv ...string) {
 		project := app.Spec.GetProject()
 		lv = append([]string{app.Namespace, app.Name, project}, lv...)
@@ -163,7 +172,7 @@ func collectApps(ch chan<- prometheus.Metric, app *argoappv1.Application) {
 		addConstMetric(desc, prometheus.GaugeValue, v, lv...)
 	}
 
-	addGauge(descAppInfo, 1, git.NormalizeGitURL(app.Spec.Source.RepoURL), app.Spec.Destination.Server, app.Spec.Destination.Namespace)
+	addGauge(descAppInfo, 1, git.NormalizeGitURL(app.Spec.Source.RepoURL), app.Spec.Destination.Server, app.Spec.Destination.Namespace, shard)
 
 	addGauge(descAppCreated, float64(app.CreationTimestamp.Unix()))
 
@@ -0,0 +1,49 @@
+package sharding
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// GetClusterShard returns the shard of the application controller replica which manages the given cluster.
+// Unless the cluster has an explicit shard number within the range of replicas, the shard is calculated by hashing
+// the cluster server URL.
+func GetClusterShard(cluster *v1alpha1.Cluster, replicas int) int {
+	if cluster.Shard != nil {
+		if shard := int(*cluster.Shard); shard >= 0 && shard < replicas {
+			return shard
+		}
+		log.Warnf("Ignoring shard %d of cluster %s: out of range of %d replicas", *cluster.Shard, cluster.Server, replicas)
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(cluster.Server))
+	return int(h.Sum32() % uint32(replicas))
+}
+
+// GetClusterFilter returns a filter which accepts clusters managed by the given shard
+func GetClusterFilter(replicas int, shard int) func(cluster *v1alpha1.Cluster) bool {
+	return func(cluster *v1alpha1.Cluster) bool {
+		return GetClusterShard(cluster, replicas) == shard
+	}
+}
+
+// InferShard infers the shard number from the hostname of a StatefulSet pod (e.g. argocd-application-controller-2)
+func InferShard() (int, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return 0, err
+	}
+	parts := strings.Split(hostname, "-")
+	shard, err := strconv.Atoi(parts[len(parts)-1])
+	if err != nil {
+		return 0, fmt.Errorf("hostname should end with the shard number separated by '-', but got: %s", hostname)
+	}
+	return shard, nil
+}
@@ -0,0 +1,53 @@
+package sharding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestGetClusterShard(t *testing.T) {
+	cluster := &v1alpha1.Cluster{Server: "https://kubernetes.default.svc"}
+	shard := GetClusterShard(cluster, 3)
+	assert.True(t, shard >= 0 && shard < 3)
+	assert.Equal(t, shard, GetClusterShard(cluster, 3))
+	assert.Equal(t, 0, GetClusterShard(cluster, 1))
+
+	explicitShard := int64(2)
+	cluster.Shard = &explicitShard
+	assert.Equal(t, 2, GetClusterShard(cluster, 3))
+
+	// shards out of the range of replicas fall back to the hash
+	assert.Equal(t, 0, GetClusterShard(cluster, 1))
+	outOfRangeShard := int64(5)
+	cluster.Shard = &outOfRangeShard
+	assert.Equal(t, shard, GetClusterShard(cluster, 3))
+	negativeShard := int64(-1)
+	cluster.Shard = &negativeShard
+	assert.Equal(t, shard, GetClusterShard(cluster, 3))
+}
+
+func TestGetClusterFilter(t *testing.T) {
+	clusters := []*v1alpha1.Cluster{
+		{Server: "https://cluster-1"},
+		{Server: "https://cluster-2"},
+		{Server: "https://cluster-3"},
+		{Server: "https://cluster-4"},
+	}
+	filters := []func(cluster *v1alpha1.Cluster) bool{
+		GetClusterFilter(2, 0),
+		GetClusterFilter(2, 1),
+	}
+	// Verify every cluster is managed by exactly one shard
+	for _, cluster := range clusters {
+		managed := 0
+		for _, filter := range filters {
+			if filter(cluster) {
+				managed++
+			}
+		}
+		assert.Equal(t, 1, managed, cluster.Server)
+	}
+}
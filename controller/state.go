@@ -57,6 +57,7 @@ type ResourceInfoProvider interface {
 type AppStateManager interface {
 	CompareAppState(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, noCache bool) (*comparisonResult, error)
 	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState)
+	RunDeleteHooks(app *v1alpha1.Application, state *v1alpha1.DeletionHooksState, hookType v1alpha1.HookType) bool
 }
 
 type comparisonResult struct {
@@ -69,6 +70,8 @@ type comparisonResult struct {
 	hooks            []*unstructured.Unstructured
 	diffNormalizer   diff.Normalizer
 	appSourceType    v1alpha1.ApplicationSourceType
+	// deletionHookTypes are the deletion hook types found in the manifests, nil if the manifests could not be generated
+	deletionHookTypes []v1alpha1.HookType
 }
 
 // appStateManager allows to compare applications to git
@@ -243,7 +246,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 	}
 
 	// Do the actual comparison
-	diffResults, err := diff.DiffArray(targetObjs, managedLiveObj, diffNormalizer)
+	diffResults, err := diff.DiffArray(targetObjs, managedLiveObj, diffNormalizer, app.GetFieldManager())
 	if err != nil {
 		return nil, err
 	}
@@ -273,6 +276,10 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		diffResult := diffResults.Diffs[i]
 		if resState.Hook {
 			// For resource hooks, don't store sync status, and do not affect overall sync status
+		} else if targetObjs[i] != nil && managedLiveObj[i] != nil && hasSyncOption(targetObjs[i], syncOptionCreateOnly) {
+			// Resources with the CreateOnly sync option are never updated once they exist, so any
+			// differences to the live resource will not be resolved by a sync
+			resState.Status = v1alpha1.SyncStatusCodeSynced
 		} else if diffResult.Modified || targetObjs[i] == nil || managedLiveObj[i] == nil {
 			// Set resource state to OutOfSync since one of the following is true:
 			// * target and live resource are different
@@ -328,6 +335,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 	}
 	if manifestInfo != nil {
 		compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfo.SourceType)
+		compRes.deletionHookTypes = deleteHookTypes(hooks)
 	}
 	return &compRes, nil
 }
@@ -341,20 +349,30 @@ func (m *appStateManager) getRepo(repoURL string) *v1alpha1.Repository {
 	return repo
 }
 
-func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource) error {
+// persistRevisionHistory records the successful sync operation in the application's deployment history,
+// keeping no more than the application's history limit of deployments
+func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, state *v1alpha1.OperationState) error {
 	var nextID int64
 	if len(app.Status.History) > 0 {
 		nextID = app.Status.History[len(app.Status.History)-1].ID + 1
 	}
+	startedAt := state.StartedAt
+	var resources []*v1alpha1.ResourceResult
+	if state.SyncResult != nil {
+		resources = state.SyncResult.Resources
+	}
 	history := append(app.Status.History, v1alpha1.RevisionHistory{
-		Revision:   revision,
-		DeployedAt: metav1.NewTime(time.Now().UTC()),
-		ID:         nextID,
-		Source:     source,
+		Revision:        revision,
+		DeployedAt:      metav1.NewTime(time.Now().UTC()),
+		DeployStartedAt: &startedAt,
+		ID:              nextID,
+		Source:          source,
+		InitiatedBy:     state.Operation.InitiatedBy,
+		Resources:       resources,
 	})
 
-	if len(history) > common.RevisionHistoryLimit {
-		history = history[1 : common.RevisionHistoryLimit+1]
+	if limit := app.Spec.GetRevisionHistoryLimit(); len(history) > limit {
+		history = history[len(history)-limit:]
 	}
 
 	patch, err := json.Marshal(map[string]map[string][]v1alpha1.RevisionHistory{
@@ -87,6 +87,47 @@ func TestCompareAppStateExtra(t *testing.T) {
 	assert.Equal(t, 0, len(compRes.conditions))
 }
 
+// TestCompareAppStateCreateOnly tests that existing resources with the CreateOnly sync option are synced, even if
+// they differ from git
+func TestCompareAppStateCreateOnly(t *testing.T) {
+	for _, createOnly := range []bool{false, true} {
+		target := test.NewPod()
+		if createOnly {
+			target.SetAnnotations(map[string]string{common.AnnotationKeySyncOptions: syncOptionCreateOnly})
+		}
+		targetJSON, err := json.Marshal(target)
+		assert.NoError(t, err)
+		live := test.NewPod()
+		live.SetNamespace(test.FakeDestNamespace)
+		live.SetLabels(map[string]string{"modified": "true"})
+		app := newFakeApp()
+		key := kube.ResourceKey{Group: "", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: live.GetName()}
+		data := fakeData{
+			manifestResponse: &repository.ManifestResponse{
+				Manifests: []string{string(targetJSON)},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				key: live,
+			},
+		}
+		ctrl := newFakeController(&data)
+		compRes, err := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(compRes.resources))
+		assert.True(t, compRes.managedResources[0].Diff.Modified)
+		if createOnly {
+			assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.resources[0].Status)
+			assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		} else {
+			assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.resources[0].Status)
+			assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+		}
+	}
+}
+
 // TestCompareAppStateHook checks that hooks are detected during manifest generation, and not
 // considered as part of resources when assessing Synced status
 func TestCompareAppStateHook(t *testing.T) {
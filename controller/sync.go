@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
@@ -14,8 +15,10 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
 
+	"github.com/argoproj/argo-cd/common"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/argo"
 	hookutil "github.com/argoproj/argo-cd/util/hook"
 	"github.com/argoproj/argo-cd/util/kube"
@@ -35,7 +38,11 @@ type syncContext struct {
 	syncRes       *appv1.SyncOperationResult
 	syncResources []appv1.SyncOperationResource
 	opState       *appv1.OperationState
-	log           *log.Entry
+	// fieldManager is used for server-side apply when the sync operation does not specify one
+	fieldManager string
+	// resourceOverrides are used to assess the health of resources between sync waves
+	resourceOverrides map[string]appv1.ResourceOverride
+	log               *log.Entry
 	// lock to protect concurrent updates of the result list
 	lock sync.Mutex
 }
@@ -93,13 +100,7 @@ func (m *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 	}
 
 	// If there are any error conditions, do not perform the operation
-	errConditions := make([]appv1.ApplicationCondition, 0)
-	for i := range compareResult.conditions {
-		if compareResult.conditions[i].IsError() {
-			errConditions = append(errConditions, compareResult.conditions[i])
-		}
-	}
-	if len(errConditions) > 0 {
+	if errConditions := getErrorConditions(compareResult.conditions); len(errConditions) > 0 {
 		state.Phase = appv1.OperationError
 		state.Message = argo.FormatAppConditions(errConditions)
 		return
@@ -109,51 +110,13 @@ func (m *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 	// what we should be syncing to when resuming operations.
 	syncRes.Revision = compareResult.syncStatus.Revision
 
-	clst, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	syncCtx, err := m.newSyncContext(app, compareResult, &syncOp, syncResources, state)
 	if err != nil {
 		state.Phase = appv1.OperationError
 		state.Message = err.Error()
 		return
 	}
 
-	restConfig := clst.RESTConfig()
-	dynamicIf, err := dynamic.NewForConfig(restConfig)
-	if err != nil {
-		state.Phase = appv1.OperationError
-		state.Message = fmt.Sprintf("Failed to initialize dynamic client: %v", err)
-		return
-	}
-	disco, err := discovery.NewDiscoveryClientForConfig(restConfig)
-	if err != nil {
-		state.Phase = appv1.OperationError
-		state.Message = fmt.Sprintf("Failed to initialize discovery client: %v", err)
-		return
-	}
-
-	proj, err := argo.GetAppProject(&app.Spec, v1alpha1.NewAppProjectLister(m.projInformer.GetIndexer()), m.namespace)
-	if err != nil {
-		state.Phase = appv1.OperationError
-		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
-		return
-	}
-
-	syncCtx := syncContext{
-		appName:       app.Name,
-		proj:          proj,
-		compareResult: compareResult,
-		config:        restConfig,
-		dynamicIf:     dynamicIf,
-		disco:         disco,
-		kubectl:       m.kubectl,
-		namespace:     app.Spec.Destination.Namespace,
-		server:        app.Spec.Destination.Server,
-		syncOp:        &syncOp,
-		syncRes:       syncRes,
-		syncResources: syncResources,
-		opState:       state,
-		log:           log.WithFields(log.Fields{"application": app.Name}),
-	}
-
 	if state.Phase == appv1.OperationTerminating {
 		syncCtx.terminate()
 	} else {
@@ -161,7 +124,7 @@ func (m *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 	}
 
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && syncCtx.opState.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source)
+		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, state)
 		if err != nil {
 			state.Phase = appv1.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -169,6 +132,60 @@ func (m *appStateManager) SyncAppState(app *appv1.Application, state *appv1.Oper
 	}
 }
 
+// getErrorConditions returns the conditions which are errors
+func getErrorConditions(conditions []appv1.ApplicationCondition) []appv1.ApplicationCondition {
+	errConditions := make([]appv1.ApplicationCondition, 0)
+	for i := range conditions {
+		if conditions[i].IsError() {
+			errConditions = append(errConditions, conditions[i])
+		}
+	}
+	return errConditions
+}
+
+// newSyncContext returns the context to perform the supplied sync operation of the application
+// against its destination cluster
+func (m *appStateManager) newSyncContext(app *appv1.Application, compareResult *comparisonResult, syncOp *appv1.SyncOperation, syncResources []appv1.SyncOperationResource, state *appv1.OperationState) (*syncContext, error) {
+	clst, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	restConfig := clst.RESTConfig()
+	dynamicIf, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to initialize dynamic client: %v", err)
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to initialize discovery client: %v", err)
+	}
+
+	proj, err := argo.GetAppProject(&app.Spec, v1alpha1.NewAppProjectLister(m.projInformer.GetIndexer()), m.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load application project: %v", err)
+	}
+
+	return &syncContext{
+		appName:           app.Name,
+		proj:              proj,
+		compareResult:     compareResult,
+		config:            restConfig,
+		dynamicIf:         dynamicIf,
+		disco:             disco,
+		kubectl:           m.kubectl,
+		namespace:         app.Spec.Destination.Namespace,
+		server:            app.Spec.Destination.Server,
+		syncOp:            syncOp,
+		syncRes:           state.SyncResult,
+		syncResources:     syncResources,
+		opState:           state,
+		fieldManager:      app.GetFieldManager(),
+		resourceOverrides: m.settings.ResourceOverrides,
+		log:               log.WithFields(log.Fields{"application": app.Name}),
+	}, nil
+}
+
 // syncTask holds the live and target object. At least one should be non-nil. A targetObj of nil
 // indicates the live object needs to be pruned. A liveObj of nil indicates the object has yet to
 // be deployed
@@ -176,6 +193,8 @@ type syncTask struct {
 	liveObj    *unstructured.Unstructured
 	targetObj  *unstructured.Unstructured
 	skipDryRun bool
+	// wave is the sync wave of the task, taken from the target object (or live object if pruning)
+	wave int
 }
 
 // sync has performs the actual apply or hook based sync
@@ -217,30 +236,69 @@ func (sc *syncContext) sync() {
 		sc.syncOp.SyncStrategy = &appv1.SyncStrategy{Hook: &appv1.SyncStrategyHook{}}
 	}
 	if sc.syncOp.SyncStrategy.Apply != nil {
-		if !sc.startedSyncPhase() {
-			if !sc.doApplySync(syncTasks, false, sc.syncOp.SyncStrategy.Apply.Force, true) {
-				sc.setOperationPhase(appv1.OperationFailed, "one or more objects failed to apply")
-				return
-			}
-			// If apply was successful, return here and force an app refresh. This is so the app
-			// will become requeued into the workqueue, to force a new sync/health assessment before
-			// marking the operation as completed
+		// The apply strategy does not run the hooks of the sync phases, but still runs SyncFail hooks
+		hooks, err := sc.getHooks(appv1.HookTypeSyncFail)
+		if err != nil {
+			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to generate hooks resources: %v", err))
 			return
 		}
-		sc.setOperationPhase(appv1.OperationSucceeded, "successfully synced")
+		sc.runWithSyncFailHooks(hooks, func() {
+			sc.runApplyPhase(syncTasks)
+		})
 	} else if sc.syncOp.SyncStrategy.Hook != nil {
 		hooks, err := sc.getHooks()
 		if err != nil {
 			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to generate hooks resources: %v", err))
 			return
 		}
-		sc.doHookSync(syncTasks, hooks)
+		sc.runWithSyncFailHooks(hooks, func() {
+			sc.runSyncPhases(syncTasks, hooks)
+		})
 	} else {
 		sc.setOperationPhase(appv1.OperationFailed, "Unknown sync strategy")
 		return
 	}
 }
 
+// runApplyPhase applies the sync tasks wave by wave without running any hooks
+func (sc *syncContext) runApplyPhase(syncTasks []syncTask) {
+	if !sc.runWaves(syncTasks, nil, appv1.HookTypeSync, sc.syncOp.SyncStrategy.Apply.Force) {
+		return
+	}
+	if !sc.waitForHealth(syncTasks) {
+		return
+	}
+	sc.setOperationPhase(appv1.OperationSucceeded, "successfully synced")
+}
+
+// waitForHealth returns whether all synced resources are healthy, if the operation requested a health
+// check. Otherwise the operation is kept running until the resources become healthy, or is failed
+// once the health check timeout elapsed.
+func (sc *syncContext) waitForHealth(syncTasks []syncTask) bool {
+	if sc.syncOp.HealthCheck == nil {
+		return true
+	}
+	timeout, err := sc.syncOp.HealthCheck.GetTimeout()
+	if err != nil {
+		sc.setOperationPhase(appv1.OperationError, err.Error())
+		return false
+	}
+	healthy, message := sc.areTasksHealthy(nonHookTasks(syncTasks))
+	if healthy {
+		return true
+	}
+	now := metav1.Now()
+	if sc.syncRes.HealthCheckStartedAt == nil {
+		sc.syncRes.HealthCheckStartedAt = &now
+	}
+	if now.Sub(sc.syncRes.HealthCheckStartedAt.Time) > timeout {
+		sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("resources did not become %s within %v (%s)", appv1.HealthStatusHealthy, timeout, message))
+		return false
+	}
+	sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("waiting for resources to become %s (%s)", appv1.HealthStatusHealthy, message))
+	return false
+}
+
 // generateSyncTasks() generates the list of sync tasks we will be performing during this sync.
 func (sc *syncContext) generateSyncTasks() ([]syncTask, bool) {
 	syncTasks := make([]syncTask, 0)
@@ -253,8 +311,26 @@ func (sc *syncContext) generateSyncTasks() ([]syncTask, bool) {
 			(resourceState.Live != nil && argo.ContainsSyncResource(resourceState.Live.GetName(), resourceState.Live.GroupVersionKind(), sc.syncResources)) ||
 			(resourceState.Target != nil && argo.ContainsSyncResource(resourceState.Target.GetName(), resourceState.Target.GroupVersionKind(), sc.syncResources)) {
 
-			skipDryRun := false
+			skipDryRun := hasSyncOption(resourceState.Target, syncOptionSkipDryRun)
 			var targetObj *unstructured.Unstructured
+			obj := resourceState.Target
+			if obj == nil {
+				obj = resourceState.Live
+			}
+			wave, err := syncWave(obj)
+			if err != nil {
+				gvk := obj.GroupVersionKind()
+				sc.setResourceDetails(&appv1.ResourceResult{
+					Name:      obj.GetName(),
+					Group:     gvk.Group,
+					Version:   gvk.Version,
+					Kind:      obj.GetKind(),
+					Namespace: util.FirstNonEmpty(obj.GetNamespace(), sc.namespace),
+					Message:   err.Error(),
+					Status:    appv1.ResultCodeSyncFailed,
+				})
+				successful = false
+			}
 			if resourceState.Target != nil {
 				targetObj = resourceState.Target.DeepCopy()
 				if targetObj.GetNamespace() == "" {
@@ -317,6 +393,7 @@ func (sc *syncContext) generateSyncTasks() ([]syncTask, bool) {
 				liveObj:    resourceState.Live,
 				targetObj:  targetObj,
 				skipDryRun: skipDryRun,
+				wave:       wave,
 			}
 			syncTasks = append(syncTasks, syncTask)
 		}
@@ -332,20 +409,6 @@ func (sc *syncContext) startedPreSyncPhase() bool {
 	return len(sc.syncRes.Resources) > 0
 }
 
-// startedSyncPhase detects if we have already started the Sync stage of a sync operation.
-// This is equal to if the resource list is non-empty, or we we see Sync/PostSync hooks
-func (sc *syncContext) startedSyncPhase() bool {
-	for _, res := range sc.syncRes.Resources {
-		if !res.IsHook() {
-			return true
-		}
-		if res.HookType == appv1.HookTypeSync || res.HookType == appv1.HookTypePostSync {
-			return true
-		}
-	}
-	return false
-}
-
 // startedPostSyncPhase detects if we have already started the PostSync stage. This is equal to if
 // we see any PostSync hooks
 func (sc *syncContext) startedPostSyncPhase() bool {
@@ -366,7 +429,7 @@ func (sc *syncContext) setOperationPhase(phase appv1.OperationPhase, message str
 }
 
 // applyObject performs a `kubectl apply` of a single resource
-func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, dryRun bool, force bool) appv1.ResourceResult {
+func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, liveObj *unstructured.Unstructured, dryRun bool, force bool) appv1.ResourceResult {
 	gvk := targetObj.GroupVersionKind()
 	resDetails := appv1.ResourceResult{
 		Name:      targetObj.GetName(),
@@ -375,13 +438,42 @@ func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, dryRun
 		Kind:      targetObj.GetKind(),
 		Namespace: targetObj.GetNamespace(),
 	}
-	message, err := sc.kubectl.ApplyResource(sc.config, targetObj, targetObj.GetNamespace(), dryRun, force)
+	if liveObj != nil && hasSyncOption(targetObj, syncOptionCreateOnly) {
+		resDetails.Message = fmt.Sprintf("skipped, resource already exists (%s)", syncOptionCreateOnly)
+		resDetails.Status = appv1.ResultCodeSynced
+		return resDetails
+	}
+	validate := !hasSyncOption(targetObj, syncOptionDisableValidation)
+	var message string
+	var err error
+	if hasSyncOption(targetObj, syncOptionReplace) {
+		message, err = sc.kubectl.ReplaceResource(sc.config, targetObj, targetObj.GetNamespace(), dryRun, force)
+	} else if applyOpts := sc.syncOp.SyncStrategy.ApplyOptions(); applyOpts != nil && applyOpts.ServerSideApply {
+		fieldManager := util.FirstNonEmpty(applyOpts.FieldManager, sc.fieldManager)
+		message, err = sc.kubectl.ServerSideApplyResource(sc.config, targetObj, targetObj.GetNamespace(), fieldManager, dryRun, force)
+	} else {
+		message, err = sc.kubectl.ApplyResource(sc.config, targetObj, targetObj.GetNamespace(), dryRun, force, validate)
+	}
 	if err != nil {
 		resDetails.Message = err.Error()
+		if isImmutableFieldError(err) && !hasSyncOption(targetObj, syncOptionReplace) {
+			resDetails.Message = fmt.Sprintf("%s. The resource cannot be updated in place, consider annotating it with '%s: %s' to replace it, or '%s: %s' to only create it when missing",
+				resDetails.Message, common.AnnotationKeySyncOptions, syncOptionReplace, common.AnnotationKeySyncOptions, syncOptionCreateOnly)
+		}
 		resDetails.Status = appv1.ResultCodeSyncFailed
 		return resDetails
 	}
 
+	var skipped []string
+	if !validate {
+		skipped = append(skipped, fmt.Sprintf("validation skipped (%s)", syncOptionDisableValidation))
+	}
+	if hasSyncOption(targetObj, syncOptionSkipDryRun) {
+		skipped = append(skipped, fmt.Sprintf("dry run skipped (%s)", syncOptionSkipDryRun))
+	}
+	if len(skipped) > 0 {
+		message = fmt.Sprintf("%s. %s", message, strings.Join(skipped, ", "))
+	}
 	resDetails.Message = message
 	resDetails.Status = appv1.ResultCodeSynced
 	return resDetails
@@ -397,7 +489,10 @@ func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dr
 		Kind:      liveObj.GetKind(),
 		Namespace: liveObj.GetNamespace(),
 	}
-	if prune {
+	if hasSyncOption(liveObj, syncOptionDisablePrune) {
+		resDetails.Message = fmt.Sprintf("ignored (pruning disabled by %s)", syncOptionDisablePrune)
+		resDetails.Status = appv1.ResultCodePruneSkipped
+	} else if prune {
 		if dryRun {
 			resDetails.Message = "pruned (dry run)"
 			resDetails.Status = appv1.ResultCodePruned
@@ -407,7 +502,7 @@ func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dr
 			// Skip deletion if object is already marked for deletion, so we don't cause a resource update hotloop
 			deletionTimestamp := liveObj.GetDeletionTimestamp()
 			if deletionTimestamp == nil || deletionTimestamp.IsZero() {
-				err := sc.kubectl.DeleteResource(sc.config, liveObj.GroupVersionKind(), liveObj.GetName(), liveObj.GetNamespace(), false)
+				err := sc.kubectl.DeleteResource(sc.config, liveObj.GroupVersionKind(), liveObj.GetName(), liveObj.GetNamespace(), kube.NewDeleteOptions(metav1.DeletePropagationForeground))
 				if err != nil {
 					resDetails.Message = err.Error()
 					resDetails.Status = appv1.ResultCodeSyncFailed
@@ -484,7 +579,7 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 				if hookutil.IsHook(t.targetObj) {
 					return
 				}
-				resDetails := sc.applyObject(t.targetObj, dryRun, force)
+				resDetails := sc.applyObject(t.targetObj, t.liveObj, dryRun, force)
 				if !resDetails.Status.Successful() {
 					syncSuccessful = false
 				}
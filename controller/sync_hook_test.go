@@ -1,11 +1,19 @@
 package controller
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/rest"
+	testcore "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/test"
@@ -64,3 +72,495 @@ func TestSyncHookProjectPermissions(t *testing.T) {
 	assert.Len(t, syncCtx.syncRes.Resources, 1)
 	assert.Equal(t, v1alpha1.ResultCodeSynced, syncCtx.syncRes.Resources[0].Status)
 }
+
+var skipHook = `
+{
+  "apiVersion": "v1",
+  "kind": "Pod",
+  "metadata": {
+    "name": "skipped-pod",
+    "annotations": {
+      "argocd.argoproj.io/hook": "Skip"
+    }
+  }
+}`
+
+func TestSyncSkipHook(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	skipped, _ := v1alpha1.UnmarshalToUnstructured(skipHook)
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Target: test.NewPod(),
+		}, {
+			Target: skipped,
+		}},
+	}
+	syncCtx.syncOp.SyncStrategy = nil
+	syncCtx.sync()
+
+	// the resource annotated with the Skip hook is not applied, but reported as skipped
+	var result *v1alpha1.ResourceResult
+	for _, res := range syncCtx.syncRes.Resources {
+		if res.Name == "skipped-pod" {
+			result = res
+		}
+	}
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "Skipped", result.Message)
+		assert.Empty(t, result.HookType)
+	}
+}
+
+var syncFailHook = `
+{
+  "apiVersion": "v1",
+  "kind": "Pod",
+  "metadata": {
+    "name": "sync-fail-hook",
+    "annotations": {
+      "argocd.argoproj.io/hook": "SyncFail"
+    }
+  }
+}`
+
+func TestSyncFailHookCompleted(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	hook, _ := v1alpha1.UnmarshalToUnstructured(syncFailHook)
+	syncCtx.compareResult = &comparisonResult{
+		hooks: []*unstructured.Unstructured{
+			hook,
+		},
+		managedResources: []managedResource{{
+			Target: test.NewPod(),
+		}},
+	}
+	syncCtx.syncOp.SyncStrategy = nil
+	syncCtx.opState.Phase = v1alpha1.OperationRunning
+	syncCtx.opState.Message = "one or more objects failed to apply"
+	syncCtx.syncRes.Resources = []*v1alpha1.ResourceResult{{
+		Name:      "sync-fail-hook",
+		Kind:      "Pod",
+		Version:   "v1",
+		Namespace: test.FakeArgoCDNamespace,
+		HookType:  v1alpha1.HookTypeSyncFail,
+		HookPhase: v1alpha1.OperationSucceeded,
+	}}
+
+	// once the SyncFail hooks completed, the operation is marked as failed with the original message
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	assert.Equal(t, "one or more objects failed to apply", syncCtx.opState.Message)
+}
+
+var failedPreSyncHook = `
+{
+  "apiVersion": "v1",
+  "kind": "Pod",
+  "metadata": {
+    "name": "pre-sync-hook",
+    "annotations": {
+      "argocd.argoproj.io/hook": "PreSync"
+    }
+  }
+}`
+
+var generateNameSyncFailHook = `
+{
+  "apiVersion": "v1",
+  "kind": "Pod",
+  "metadata": {
+    "generateName": "sync-fail-hook-",
+    "annotations": {
+      "argocd.argoproj.io/hook": "SyncFail"
+    }
+  }
+}`
+
+// newGenerateNameDynamicClient returns a fake dynamic client which names created pods after their generateName
+func newGenerateNameDynamicClient() *fake.FakeDynamicClient {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	client.PrependReactor("create", "pods", func(action testcore.Action) (bool, runtime.Object, error) {
+		obj := action.(testcore.CreateAction).GetObject().(*unstructured.Unstructured)
+		if obj.GetName() == "" {
+			obj.SetName(obj.GetGenerateName() + "abcde")
+		}
+		return false, nil, nil
+	})
+	return client
+}
+
+func TestSyncFailHookRunAfterPhaseFailed(t *testing.T) {
+	syncCtx := newTestSyncCtx(podsResourceList)
+	syncCtx.dynamicIf = newGenerateNameDynamicClient()
+	preSyncHook, _ := v1alpha1.UnmarshalToUnstructured(failedPreSyncHook)
+	syncFailHook, _ := v1alpha1.UnmarshalToUnstructured(generateNameSyncFailHook)
+	syncCtx.compareResult = &comparisonResult{
+		hooks: []*unstructured.Unstructured{
+			preSyncHook,
+			syncFailHook,
+		},
+		managedResources: []managedResource{{
+			Target: test.NewPod(),
+		}},
+	}
+	syncCtx.syncOp.SyncStrategy = nil
+	syncCtx.opState.Phase = v1alpha1.OperationRunning
+	syncCtx.syncRes.Resources = []*v1alpha1.ResourceResult{{
+		Name:      "pre-sync-hook",
+		Kind:      "Pod",
+		Version:   "v1",
+		Namespace: test.FakeArgoCDNamespace,
+		HookType:  v1alpha1.HookTypePreSync,
+		HookPhase: v1alpha1.OperationFailed,
+	}}
+
+	// the operation is kept running with the original failure message while the SyncFail hooks run
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Equal(t, "PreSync hook failed", syncCtx.opState.Message)
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	assert.Equal(t, v1alpha1.HookTypeSyncFail, syncCtx.syncRes.Resources[1].HookType)
+	podIf := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace(test.FakeArgoCDNamespace)
+	_, err := podIf.Get("sync-fail-hook-abcde", v1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+// failingApplyKubectl is a kubectl whose applies fail, except for dry runs
+type failingApplyKubectl struct {
+	kubetest.MockKubectlCmd
+}
+
+func (k failingApplyKubectl) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error) {
+	if dryRun {
+		return "", nil
+	}
+	return "", fmt.Errorf("failed to apply %s", obj.GetName())
+}
+
+func TestSyncFailHookRunWithApplyStrategy(t *testing.T) {
+	syncCtx := newTestSyncCtx(podsResourceList)
+	syncCtx.kubectl = failingApplyKubectl{}
+	syncCtx.dynamicIf = newGenerateNameDynamicClient()
+	syncFailHook, _ := v1alpha1.UnmarshalToUnstructured(generateNameSyncFailHook)
+	syncCtx.compareResult = &comparisonResult{
+		hooks: []*unstructured.Unstructured{
+			syncFailHook,
+		},
+		managedResources: []managedResource{{
+			Target: test.NewPod(),
+		}},
+	}
+
+	// the apply strategy runs SyncFail hooks, too
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Equal(t, "one or more objects failed to apply", syncCtx.opState.Message)
+	podIf := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace(test.FakeArgoCDNamespace)
+	_, err := podIf.Get("sync-fail-hook-abcde", v1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestSyncFailHookRunAfterPhaseError(t *testing.T) {
+	syncCtx := newTestSyncCtx(podsResourceList)
+	client := newGenerateNameDynamicClient()
+	client.PrependReactor("create", "pods", func(action testcore.Action) (bool, runtime.Object, error) {
+		obj := action.(testcore.CreateAction).GetObject().(*unstructured.Unstructured)
+		if obj.GetName() == "pre-sync-hook" {
+			return true, nil, fmt.Errorf("admission webhook denied the request")
+		}
+		return false, nil, nil
+	})
+	syncCtx.dynamicIf = client
+	preSyncHook, _ := v1alpha1.UnmarshalToUnstructured(failedPreSyncHook)
+	syncFailHook, _ := v1alpha1.UnmarshalToUnstructured(generateNameSyncFailHook)
+	syncCtx.compareResult = &comparisonResult{
+		hooks: []*unstructured.Unstructured{
+			preSyncHook,
+			syncFailHook,
+		},
+		managedResources: []managedResource{{
+			Target: test.NewPod(),
+		}},
+	}
+	syncCtx.syncOp.SyncStrategy = nil
+
+	// SyncFail hooks also run if a phase ended with an error
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Contains(t, syncCtx.opState.Message, "admission webhook denied the request")
+	podIf := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace(test.FakeArgoCDNamespace)
+	_, err := podIf.Get("sync-fail-hook-abcde", v1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestSyncFailHookNotRunAfterPermissionDenied(t *testing.T) {
+	syncCtx := newTestSyncCtx(podsResourceList, &v1.APIResourceList{
+		GroupVersion: "rbac.authorization.k8s.io/v1",
+		APIResources: []v1.APIResource{
+			{Name: "clusterroles", Namespaced: false, Kind: "ClusterRole", Group: "rbac.authorization.k8s.io"},
+		},
+	})
+	syncCtx.dynamicIf = newGenerateNameDynamicClient()
+	crHook, _ := v1alpha1.UnmarshalToUnstructured(clusterRoleHook)
+	syncFailHook, _ := v1alpha1.UnmarshalToUnstructured(generateNameSyncFailHook)
+	syncCtx.compareResult = &comparisonResult{
+		hooks: []*unstructured.Unstructured{
+			crHook,
+			syncFailHook,
+		},
+		managedResources: []managedResource{{
+			Target: test.NewPod(),
+		}},
+	}
+	syncCtx.proj.Spec.ClusterResourceWhitelist = []v1.GroupKind{}
+	syncCtx.syncOp.SyncStrategy = nil
+
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	assert.Contains(t, syncCtx.opState.Message, "not permitted in project")
+	assert.Len(t, syncCtx.syncRes.Resources, 0)
+	podIf := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace(test.FakeArgoCDNamespace)
+	_, err := podIf.Get("sync-fail-hook-abcde", v1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+var podsResourceList = &v1.APIResourceList{
+	GroupVersion: "v1",
+	APIResources: []v1.APIResource{
+		{Name: "pods", Namespaced: true, Kind: "Pod", Version: "v1"},
+	},
+}
+
+var beforeHookCreationHook = `
+{
+  "apiVersion": "v1",
+  "kind": "Pod",
+  "metadata": {
+    "name": "migrate",
+    "annotations": {
+      "argocd.argoproj.io/hook": "PreSync",
+      "argocd.argoproj.io/hook-delete-policy": "BeforeHookCreation"
+    }
+  }
+}`
+
+func TestSyncHookBeforeHookCreation(t *testing.T) {
+	syncCtx := newTestSyncCtx(podsResourceList)
+	hook, _ := v1alpha1.UnmarshalToUnstructured(beforeHookCreationHook)
+	previous := hook.DeepCopy()
+	previous.SetNamespace(test.FakeArgoCDNamespace)
+	previous.SetUID("previous-uid")
+	syncCtx.dynamicIf = fake.NewSimpleDynamicClient(runtime.NewScheme(), previous)
+	syncCtx.compareResult = &comparisonResult{
+		hooks: []*unstructured.Unstructured{
+			hook,
+		},
+		managedResources: []managedResource{{
+			Target: test.NewPod(),
+		}},
+	}
+	syncCtx.syncOp.SyncStrategy = nil
+	syncCtx.sync()
+
+	// the instance of the previous sync is deleted before the hook is created again
+	podIf := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace(test.FakeArgoCDNamespace)
+	_, err := podIf.Get("migrate", v1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.syncRes.Resources[0].HookPhase)
+	assert.Equal(t, "waiting for the previous instance of the hook to be deleted", syncCtx.syncRes.Resources[0].Message)
+}
+
+var generateNameHook = `
+{
+  "apiVersion": "v1",
+  "kind": "Pod",
+  "metadata": {
+    "generateName": "migrate-",
+    "annotations": {
+      "argocd.argoproj.io/hook": "PreSync"
+    }
+  }
+}`
+
+func TestSyncHookGenerateName(t *testing.T) {
+	syncCtx := newTestSyncCtx(podsResourceList)
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	client.PrependReactor("create", "pods", func(action testcore.Action) (bool, runtime.Object, error) {
+		obj := action.(testcore.CreateAction).GetObject().(*unstructured.Unstructured)
+		obj.SetName(obj.GetGenerateName() + "abcde")
+		obj.SetUID("generated-uid")
+		return false, nil, nil
+	})
+	syncCtx.dynamicIf = client
+	hook, _ := v1alpha1.UnmarshalToUnstructured(generateNameHook)
+	syncCtx.compareResult = &comparisonResult{
+		hooks: []*unstructured.Unstructured{
+			hook,
+		},
+		managedResources: []managedResource{{
+			Target: test.NewPod(),
+		}},
+	}
+	syncCtx.syncOp.SyncStrategy = nil
+	syncCtx.sync()
+
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "migrate-abcde", syncCtx.syncRes.Resources[0].Name)
+	assert.Equal(t, "generated-uid", syncCtx.syncRes.Resources[0].UID)
+
+	// the status of the created instance is found from the hook manifest
+	manifest, _ := v1alpha1.UnmarshalToUnstructured(generateNameHook)
+	manifest.SetNamespace(test.FakeArgoCDNamespace)
+	assert.NotNil(t, syncCtx.getHookStatus(manifest, v1alpha1.HookTypePreSync, map[string]bool{}))
+}
+
+func TestSyncHookDeletedWhileRunning(t *testing.T) {
+	syncCtx := newTestSyncCtx(podsResourceList)
+	syncCtx.dynamicIf = newSequentialNameDynamicClient()
+	hook, _ := v1alpha1.UnmarshalToUnstructured(generateNameHook)
+	syncCtx.compareResult = &comparisonResult{
+		hooks: []*unstructured.Unstructured{hook},
+	}
+	syncCtx.syncOp.SyncStrategy = nil
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.syncRes.Resources[0].HookPhase)
+
+	podIf := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace(test.FakeArgoCDNamespace)
+	assert.NoError(t, podIf.Delete("migrate-00001", &v1.DeleteOptions{}))
+	syncCtx.sync()
+
+	// the deleted hook fails the operation instead of resulting in an error
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.syncRes.Resources[0].HookPhase)
+	assert.Contains(t, syncCtx.syncRes.Resources[0].Message, "was deleted")
+	assert.NotEqual(t, v1alpha1.OperationError, syncCtx.opState.Phase)
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+}
+
+var namedMigrateHook = `
+{
+  "apiVersion": "v1",
+  "kind": "Pod",
+  "metadata": {
+    "name": "migrate-abcde",
+    "annotations": {
+      "argocd.argoproj.io/hook": "PreSync"
+    }
+  }
+}`
+
+// newSequentialNameDynamicClient returns a fake dynamic client which assigns unique names and UIDs to
+// pods created from their generateName
+func newSequentialNameDynamicClient() *fake.FakeDynamicClient {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	created := 0
+	client.PrependReactor("create", "pods", func(action testcore.Action) (bool, runtime.Object, error) {
+		obj := action.(testcore.CreateAction).GetObject().(*unstructured.Unstructured)
+		created++
+		if obj.GetName() == "" {
+			obj.SetName(fmt.Sprintf("%s%05d", obj.GetGenerateName(), created))
+		}
+		obj.SetUID(types.UID(fmt.Sprintf("uid-%d", created)))
+		return false, nil, nil
+	})
+	return client
+}
+
+func TestSyncHookGenerateNameNextToNamedHook(t *testing.T) {
+	syncCtx := newTestSyncCtx(podsResourceList)
+	syncCtx.dynamicIf = newSequentialNameDynamicClient()
+	named, _ := v1alpha1.UnmarshalToUnstructured(namedMigrateHook)
+	generated, _ := v1alpha1.UnmarshalToUnstructured(generateNameHook)
+	// the named hook already exists, e.g. from a previous sync
+	named.SetNamespace(test.FakeArgoCDNamespace)
+	podIf := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace(test.FakeArgoCDNamespace)
+	_, err := podIf.Create(named.DeepCopy(), v1.CreateOptions{})
+	assert.NoError(t, err)
+	syncCtx.compareResult = &comparisonResult{
+		hooks: []*unstructured.Unstructured{named, generated},
+	}
+	syncCtx.syncOp.SyncStrategy = nil
+	syncCtx.sync()
+	syncCtx.sync()
+
+	// the named hook is not mistaken for an instance of the hook with the same generateName prefix
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	assert.Equal(t, "migrate-abcde", syncCtx.syncRes.Resources[0].Name)
+	assert.Equal(t, "", syncCtx.syncRes.Resources[0].GenerateName)
+	assert.Equal(t, "migrate-00002", syncCtx.syncRes.Resources[1].Name)
+	assert.Equal(t, "migrate-", syncCtx.syncRes.Resources[1].GenerateName)
+}
+
+func TestSyncHooksWithSameGenerateName(t *testing.T) {
+	syncCtx := newTestSyncCtx(podsResourceList)
+	syncCtx.dynamicIf = newSequentialNameDynamicClient()
+	first, _ := v1alpha1.UnmarshalToUnstructured(generateNameHook)
+	second, _ := v1alpha1.UnmarshalToUnstructured(generateNameHook)
+	syncCtx.compareResult = &comparisonResult{
+		hooks: []*unstructured.Unstructured{first, second},
+	}
+	syncCtx.syncOp.SyncStrategy = nil
+	syncCtx.sync()
+	syncCtx.sync()
+
+	// each hook is matched to its own instance, and no further instances are created
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	assert.Equal(t, "migrate-00001", syncCtx.syncRes.Resources[0].Name)
+	assert.Equal(t, "migrate-00002", syncCtx.syncRes.Resources[1].Name)
+	podIf := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace(test.FakeArgoCDNamespace)
+	_, err := podIf.Get("migrate-00003", v1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+var preDeleteHook = `
+{
+  "apiVersion": "v1",
+  "kind": "Pod",
+  "metadata": {
+    "generateName": "backup-",
+    "annotations": {
+      "argocd.argoproj.io/hook": "PreDelete"
+    }
+  }
+}`
+
+func TestRunDeleteHooks(t *testing.T) {
+	syncCtx := newTestSyncCtx(podsResourceList)
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	client.PrependReactor("create", "pods", func(action testcore.Action) (bool, runtime.Object, error) {
+		obj := action.(testcore.CreateAction).GetObject().(*unstructured.Unstructured)
+		obj.SetName(obj.GetGenerateName() + "abcde")
+		obj.SetUID("generated-uid")
+		_ = unstructured.SetNestedField(obj.Object, "Running", "status", "phase")
+		return false, nil, nil
+	})
+	syncCtx.dynamicIf = client
+	hook, _ := v1alpha1.UnmarshalToUnstructured(preDeleteHook)
+	syncCtx.compareResult = &comparisonResult{
+		hooks: []*unstructured.Unstructured{hook},
+	}
+
+	// the application has no PostDelete hooks
+	assert.True(t, syncCtx.runDeleteHooks(v1alpha1.HookTypePostDelete))
+	assert.Len(t, syncCtx.syncRes.Resources, 0)
+
+	// the deletion waits for the PreDelete hook to complete
+	assert.False(t, syncCtx.runDeleteHooks(v1alpha1.HookTypePreDelete))
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.HookTypePreDelete, syncCtx.syncRes.Resources[0].HookType)
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.syncRes.Resources[0].HookPhase)
+
+	podIf := client.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace(test.FakeArgoCDNamespace)
+	pod, err := podIf.Get("backup-abcde", v1.GetOptions{})
+	assert.NoError(t, err)
+	_ = unstructured.SetNestedField(pod.Object, "Succeeded", "status", "phase")
+	_, err = podIf.Update(pod, v1.UpdateOptions{})
+	assert.NoError(t, err)
+
+	assert.True(t, syncCtx.runDeleteHooks(v1alpha1.HookTypePreDelete))
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.syncRes.Resources[0].HookPhase)
+}
@@ -21,34 +21,42 @@ import (
 	"github.com/argoproj/argo-cd/util/kube"
 )
 
-// doHookSync initiates (or continues) a hook-based sync. This method will be invoked when there may
-// already be in-flight (potentially incomplete) jobs/workflows, and should be idempotent.
-func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Unstructured) {
-	if !sc.startedPreSyncPhase() {
-		if !sc.verifyPermittedHooks(hooks) {
+// runWithSyncFailHooks initiates (or continues) a sync by running its phases, followed by the
+// SyncFail hooks if the phases failed or errored. This method will be invoked when there may already
+// be in-flight (potentially incomplete) jobs/workflows, and should be idempotent.
+func (sc *syncContext) runWithSyncFailHooks(hooks []*unstructured.Unstructured, runPhases func()) {
+	if !sc.startedSyncFailPhase() {
+		if !sc.startedPreSyncPhase() && !sc.verifyPermittedHooks(hooks) {
+			// SyncFail hooks are not run either, since they were verified together with the other
+			// hooks and might be the ones refused by the project
 			return
 		}
+		runPhases()
+		if sc.opState.Phase != appv1.OperationFailed && sc.opState.Phase != appv1.OperationError {
+			return
+		}
+		syncFailHooks, _ := sc.getHooks(appv1.HookTypeSyncFail)
+		if len(syncFailHooks) == 0 {
+			return
+		}
+		sc.log.Infof("Running %s hooks after failure: %s", appv1.HookTypeSyncFail, sc.opState.Message)
 	}
-	// 1. Run PreSync hooks
-	if !sc.runHooks(hooks, appv1.HookTypePreSync) {
+	sc.runSyncFailHooks(hooks)
+}
+
+// runSyncPhases runs the PreSync, Sync and PostSync phases of a hook-based sync
+func (sc *syncContext) runSyncPhases(syncTasks []syncTask, hooks []*unstructured.Unstructured) {
+	// 1. Run PreSync hooks, wave by wave
+	if !sc.runWaves(nil, hooks, appv1.HookTypePreSync, false) {
 		return
 	}
 
 	// 2. Run Sync hooks (e.g. blue-green sync workflow)
-	// Before performing Sync hooks, apply any normal manifests which aren't annotated with a hook.
-	// We only want to do this once per operation.
-	shouldContinue := true
-	if !sc.startedSyncPhase() {
-		if !sc.syncNonHookTasks(syncTasks) {
-			sc.setOperationPhase(appv1.OperationFailed, "one or more objects failed to apply")
-			return
-		}
-		shouldContinue = false
-	}
-	if !sc.runHooks(hooks, appv1.HookTypeSync) {
-		shouldContinue = false
-	}
-	if !shouldContinue {
+	// Within each wave, apply any normal manifests which aren't annotated with a hook before
+	// performing the Sync hooks of that wave. Resources annotated with the Skip hook are reported
+	// as skipped in their wave.
+	syncHooks := append(skipHookTargets(syncTasks), hooks...)
+	if !sc.runWaves(nonHookTasks(syncTasks), syncHooks, appv1.HookTypeSync, sc.syncOp.SyncStrategy.Hook.Force) {
 		return
 	}
 
@@ -64,7 +72,10 @@ func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Un
 			return
 		}
 	}
-	if !sc.runHooks(hooks, appv1.HookTypePostSync) {
+	if !sc.runWaves(nil, hooks, appv1.HookTypePostSync, false) {
+		return
+	}
+	if !sc.waitForHealth(syncTasks) {
 		return
 	}
 
@@ -72,6 +83,36 @@ func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Un
 	sc.setOperationPhase(appv1.OperationSucceeded, "successfully synced")
 }
 
+// runSyncFailHooks runs the SyncFail hooks after one of the sync phases failed or errored. The
+// operation is kept Running with the message of the original failure until all SyncFail hooks
+// completed, after which the operation is marked as Failed.
+func (sc *syncContext) runSyncFailHooks(hooks []*unstructured.Unstructured) {
+	failMessage := sc.opState.Message
+	sc.setOperationPhase(appv1.OperationRunning, failMessage)
+	completed := sc.runWaves(nil, hooks, appv1.HookTypeSyncFail, false)
+	switch {
+	case sc.opState.Phase == appv1.OperationError:
+		// failed to run the hooks, message is already set
+	case sc.opState.Phase == appv1.OperationFailed:
+		sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("%s (%s hook failed)", failMessage, appv1.HookTypeSyncFail))
+	case completed:
+		sc.setOperationPhase(appv1.OperationFailed, failMessage)
+	default:
+		sc.setOperationPhase(appv1.OperationRunning, failMessage)
+	}
+}
+
+// startedSyncFailPhase detects if we have already started running SyncFail hooks. This is equal
+// to if we see any SyncFail hooks
+func (sc *syncContext) startedSyncFailPhase() bool {
+	for _, res := range sc.syncRes.Resources {
+		if res.IsHook() && res.HookType == appv1.HookTypeSyncFail {
+			return true
+		}
+	}
+	return false
+}
+
 // verifyPermittedHooks verifies all hooks are permitted in the project
 func (sc *syncContext) verifyPermittedHooks(hooks []*unstructured.Unstructured) bool {
 	for _, hook := range hooks {
@@ -127,6 +168,9 @@ func (sc *syncContext) getHooks(hookTypes ...appv1.HookType) ([]*unstructured.Un
 				continue
 			}
 		}
+		if _, err := syncWave(hook); err != nil {
+			return nil, fmt.Errorf("hook %s/%s: %v", hook.GetKind(), hook.GetName(), err)
+		}
 		hooks = append(hooks, hook)
 	}
 	return hooks, nil
@@ -137,6 +181,9 @@ func (sc *syncContext) getHooks(hookTypes ...appv1.HookType) ([]*unstructured.Un
 // we should continue to the next hook phase.
 func (sc *syncContext) runHooks(hooks []*unstructured.Unstructured, hookType appv1.HookType) bool {
 	shouldContinue := true
+	// UIDs of the hook instances which were already matched to one of the hooks, so that hooks
+	// sharing the same metadata.generateName are matched to different instances
+	matched := make(map[string]bool)
 	for _, hook := range hooks {
 		if hookType == appv1.HookTypeSync && isHookType(hook, appv1.HookTypeSkip) {
 			// If we get here, we are invoking all sync hooks and reached a resource that is
@@ -156,7 +203,7 @@ func (sc *syncContext) runHooks(hooks []*unstructured.Unstructured, hookType app
 		if !isHookType(hook, hookType) {
 			continue
 		}
-		updated, err := sc.runHook(hook, hookType)
+		updated, err := sc.runHook(hook, hookType, matched)
 		if err != nil {
 			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("%s hook error: %v", hookType, err))
 			return false
@@ -187,13 +234,12 @@ func (sc *syncContext) runHooks(hooks []*unstructured.Unstructured, hookType app
 	return true
 }
 
-// syncNonHookTasks syncs or prunes the objects that are not handled by hooks using an apply sync.
-// returns true if the sync was successful
-func (sc *syncContext) syncNonHookTasks(syncTasks []syncTask) bool {
-	var nonHookTasks []syncTask
+// nonHookTasks filters the tasks which syncs or prunes the objects that are not handled by hooks
+func nonHookTasks(syncTasks []syncTask) []syncTask {
+	var tasks []syncTask
 	for _, task := range syncTasks {
 		if task.targetObj == nil {
-			nonHookTasks = append(nonHookTasks, task)
+			tasks = append(tasks, task)
 		} else {
 			annotations := task.targetObj.GetAnnotations()
 			if annotations != nil && annotations[common.AnnotationKeyHook] != "" {
@@ -202,27 +248,32 @@ func (sc *syncContext) syncNonHookTasks(syncTasks []syncTask) bool {
 			}
 			// if we get here, this resource does not have any hook annotation so we
 			// should perform an `kubectl apply`
-			nonHookTasks = append(nonHookTasks, task)
+			tasks = append(tasks, task)
 		}
 	}
-	return sc.doApplySync(nonHookTasks, false, sc.syncOp.SyncStrategy.Hook.Force, true)
+	return tasks
+}
+
+// skipHookTargets returns the target objects of the tasks which are annotated with the Skip hook
+func skipHookTargets(syncTasks []syncTask) []*unstructured.Unstructured {
+	var targets []*unstructured.Unstructured
+	for _, task := range syncTasks {
+		if task.targetObj != nil && isHookType(task.targetObj, appv1.HookTypeSkip) {
+			targets = append(targets, task.targetObj)
+		}
+	}
+	return targets
 }
 
 // runHook runs the supplied hook and updates the hook status. Returns true if the result of
 // invoking this method resulted in changes to any hook status
-func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.HookType) (bool, error) {
-	// Hook resources names are deterministic, whether they are defined by the user (metadata.name),
-	// or formulated at the time of the operation (metadata.generateName). If user specifies
-	// metadata.generateName, then we will generate a formulated metadata.name before submission.
-	if hook.GetName() == "" {
-		postfix := strings.ToLower(fmt.Sprintf("%s-%s-%d", sc.syncRes.Revision[0:7], hookType, sc.opState.StartedAt.UTC().Unix()))
-		generatedName := hook.GetGenerateName()
-		hook = hook.DeepCopy()
-		hook.SetName(fmt.Sprintf("%s%s", generatedName, postfix))
-	}
+func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.HookType, matched map[string]bool) (bool, error) {
 	// Check our hook statuses to see if we already completed this hook.
 	// If so, this method is a noop
-	prevStatus := sc.getHookStatus(hook, hookType)
+	prevStatus := sc.getHookStatus(hook, hookType, matched)
+	if prevStatus != nil && prevStatus.UID != "" {
+		matched[prevStatus.UID] = true
+	}
 	if prevStatus != nil && prevStatus.HookPhase.Completed() {
 		return false, nil
 	}
@@ -236,29 +287,76 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 	resIf := kube.ToResourceInterface(sc.dynamicIf, apiResource, resource, hook.GetNamespace())
 
 	var liveObj *unstructured.Unstructured
-	existing, err := resIf.Get(hook.GetName(), metav1.GetOptions{})
-	if err != nil {
-		if !apierr.IsNotFound(err) {
-			return false, fmt.Errorf("Failed to get status of %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
+	if prevStatus != nil && prevStatus.UID != "" {
+		// The hook was already created during this operation. The recorded name is used, since
+		// the name of hooks with metadata.generateName is only known after their creation.
+		liveObj, err = resIf.Get(prevStatus.Name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			hookStatus := *prevStatus
+			hookStatus.HookPhase = appv1.OperationFailed
+			hookStatus.Message = fmt.Sprintf("%s hook %s '%s' was deleted before it completed", hookType, gvk, prevStatus.Name)
+			return sc.updateHookStatus(hookStatus), nil
 		}
-		_, err := sc.kubectl.ApplyResource(sc.config, hook, hook.GetNamespace(), false, false)
 		if err != nil {
-			return false, fmt.Errorf("Failed to create %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
+			return false, fmt.Errorf("Failed to get status of %s hook %s '%s': %v", hookType, gvk, prevStatus.Name, err)
 		}
-		created, err := resIf.Get(hook.GetName(), metav1.GetOptions{})
+		if string(liveObj.GetUID()) != prevStatus.UID {
+			hookStatus := *prevStatus
+			hookStatus.HookPhase = appv1.OperationFailed
+			hookStatus.Message = fmt.Sprintf("%s hook %s '%s' was replaced by another object", hookType, gvk, prevStatus.Name)
+			return sc.updateHookStatus(hookStatus), nil
+		}
+	} else if hook.GetName() == "" {
+		// Hooks with metadata.generateName get a new name from the API server on every creation,
+		// so they never conflict with instances of previous syncs
+		liveObj, err = resIf.Create(hook, metav1.CreateOptions{})
 		if err != nil {
-			return true, fmt.Errorf("Failed to get status of %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
+			return false, fmt.Errorf("Failed to create %s hook %s '%s': %v", hookType, gvk, hook.GetGenerateName(), err)
 		}
-		sc.log.Infof("%s hook %s '%s' created", hookType, gvk, created.GetName())
+		sc.log.Infof("%s hook %s '%s' created", hookType, gvk, liveObj.GetName())
 		sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("running %s hooks", hookType))
-		liveObj = created
 	} else {
-		liveObj = existing
+		existing, err := resIf.Get(hook.GetName(), metav1.GetOptions{})
+		switch {
+		case err != nil && !apierr.IsNotFound(err):
+			return false, fmt.Errorf("Failed to get status of %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
+		case err == nil && hasHookDeletePolicy(hook, appv1.HookDeletePolicyBeforeHookCreation):
+			// An instance of the hook from a previous sync still exists. Delete it and wait for it
+			// to be gone before the hook is created again.
+			if existing.GetDeletionTimestamp() == nil {
+				err = sc.deleteHook(existing.GetName(), existing.GetNamespace(), gvk)
+				if err != nil && !apierr.IsNotFound(err) {
+					return false, fmt.Errorf("Failed to delete previous instance of %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
+				}
+				sc.log.Infof("Deleted previous instance of %s hook %s '%s'", hookType, gvk, hook.GetName())
+			}
+			hookStatus := newHookStatus(hook, hookType)
+			hookStatus.HookPhase = appv1.OperationRunning
+			hookStatus.Message = "waiting for the previous instance of the hook to be deleted"
+			return sc.updateHookStatus(hookStatus), nil
+		case err == nil:
+			liveObj = existing
+		default:
+			_, err := sc.kubectl.ApplyResource(sc.config, hook, hook.GetNamespace(), false, false, true)
+			if err != nil {
+				return false, fmt.Errorf("Failed to create %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
+			}
+			liveObj, err = resIf.Get(hook.GetName(), metav1.GetOptions{})
+			if err != nil {
+				return true, fmt.Errorf("Failed to get status of %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
+			}
+			sc.log.Infof("%s hook %s '%s' created", hookType, gvk, liveObj.GetName())
+			sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("running %s hooks", hookType))
+		}
 	}
 	hookStatus := newHookStatus(liveObj, hookType)
+	if hook.GetName() == "" {
+		hookStatus.GenerateName = hook.GetGenerateName()
+		matched[hookStatus.UID] = true
+	}
 	if hookStatus.HookPhase.Completed() {
 		if enforceHookDeletePolicy(hook, hookStatus.HookPhase) {
-			err = sc.deleteHook(hook.GetName(), hook.GetNamespace(), hook.GroupVersionKind())
+			err = sc.deleteHook(liveObj.GetName(), liveObj.GetNamespace(), liveObj.GroupVersionKind())
 			if err != nil {
 				hookStatus.HookPhase = appv1.OperationFailed
 				hookStatus.Message = fmt.Sprintf("failed to delete %s hook: %v", hookStatus.HookPhase, err)
@@ -268,25 +366,31 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 	return sc.updateHookStatus(hookStatus), nil
 }
 
-// enforceHookDeletePolicy examines the hook deletion policy of a object and deletes it based on the status
-func enforceHookDeletePolicy(hook *unstructured.Unstructured, phase appv1.OperationPhase) bool {
+// hasHookDeletePolicy returns whether the hook deletion policy annotation of the hook contains the policy
+func hasHookDeletePolicy(hook *unstructured.Unstructured, policy appv1.HookDeletePolicy) bool {
 	annotations := hook.GetAnnotations()
 	if annotations == nil {
 		return false
 	}
-	deletePolicies := strings.Split(annotations[common.AnnotationKeyHookDeletePolicy], ",")
-	for _, dp := range deletePolicies {
-		policy := appv1.HookDeletePolicy(strings.TrimSpace(dp))
-		if policy == appv1.HookDeletePolicyHookSucceeded && phase == appv1.OperationSucceeded {
-			return true
-		}
-		if policy == appv1.HookDeletePolicyHookFailed && phase == appv1.OperationFailed {
+	for _, dp := range strings.Split(annotations[common.AnnotationKeyHookDeletePolicy], ",") {
+		if appv1.HookDeletePolicy(strings.TrimSpace(dp)) == policy {
 			return true
 		}
 	}
 	return false
 }
 
+// enforceHookDeletePolicy examines the hook deletion policy of a object and deletes it based on the status
+func enforceHookDeletePolicy(hook *unstructured.Unstructured, phase appv1.OperationPhase) bool {
+	switch phase {
+	case appv1.OperationSucceeded:
+		return hasHookDeletePolicy(hook, appv1.HookDeletePolicyHookSucceeded)
+	case appv1.OperationFailed:
+		return hasHookDeletePolicy(hook, appv1.HookDeletePolicyHookFailed)
+	}
+	return false
+}
+
 // isHookType tells whether or not the supplied object is a hook of the specified type
 func isHookType(hook *unstructured.Unstructured, hookType appv1.HookType) bool {
 	annotations := hook.GetAnnotations()
@@ -313,6 +417,7 @@ func newHookStatus(hook *unstructured.Unstructured, hookType appv1.HookType) app
 		HookType:  hookType,
 		HookPhase: appv1.OperationRunning,
 		Namespace: hook.GetNamespace(),
+		UID:       string(hook.GetUID()),
 	}
 	if isBatchJob(gvk) {
 		updateStatusFromBatchJob(hook, &hookStatus)
@@ -447,13 +552,26 @@ func updateStatusFromPod(hook *unstructured.Unstructured, hookStatus *appv1.Reso
 	}
 }
 
-func (sc *syncContext) getHookStatus(hookObj *unstructured.Unstructured, hookType appv1.HookType) *appv1.ResourceResult {
+// getHookStatus returns the status of the instance of the hook created during this operation. Hooks
+// with metadata.generateName are matched by the recorded generateName, skipping the instances whose
+// UIDs were already matched to other hooks.
+func (sc *syncContext) getHookStatus(hookObj *unstructured.Unstructured, hookType appv1.HookType, matched map[string]bool) *appv1.ResourceResult {
+	ns := util.FirstNonEmpty(hookObj.GetNamespace(), sc.namespace)
 	for _, hr := range sc.syncRes.Resources {
-		if !hr.IsHook() {
+		if !hr.IsHook() || hr.UID != "" && matched[hr.UID] {
+			continue
+		}
+		name := hookObj.GetName()
+		if name == "" {
+			if hr.GenerateName != hookObj.GetGenerateName() {
+				continue
+			}
+			// the hook was created from metadata.generateName, so match the name it was given
+			name = hr.Name
+		} else if hr.GenerateName != "" {
 			continue
 		}
-		ns := util.FirstNonEmpty(hookObj.GetNamespace(), sc.namespace)
-		if hookEqual(hr, hookObj.GroupVersionKind().Group, hookObj.GetKind(), ns, hookObj.GetName(), hookType) {
+		if hookEqual(hr, hookObj.GroupVersionKind().Group, hookObj.GetKind(), ns, name, hookType) {
 			return hr
 		}
 	}
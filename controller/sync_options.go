@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+)
+
+const (
+	// syncOptionDisablePrune prevents the resource from being pruned
+	syncOptionDisablePrune = "Prune=false"
+	// syncOptionDisableValidation disables the schema validation of `kubectl apply`
+	syncOptionDisableValidation = "Validate=false"
+	// syncOptionReplace replaces the resource instead of applying it
+	syncOptionReplace = "Replace=true"
+	// syncOptionSkipDryRun skips the dry run of the resource, e.g. for resources of a CRD which is
+	// created during the same sync
+	syncOptionSkipDryRun = "SkipDryRun=true"
+	// syncOptionDisableDeletion prevents the resource from being deleted when the app is deleted
+	syncOptionDisableDeletion = "Delete=false"
+	// syncOptionCreateOnly creates the resource if it is missing, but never updates an existing one
+	syncOptionCreateOnly = "CreateOnly=true"
+)
+
+// hasSyncOption returns whether or not the sync-options annotation of the supplied object contains
+// the given option
+func hasSyncOption(obj *unstructured.Unstructured, option string) bool {
+	if obj == nil {
+		return false
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		return false
+	}
+	for _, opt := range strings.Split(annotations[common.AnnotationKeySyncOptions], ",") {
+		if strings.TrimSpace(opt) == option {
+			return true
+		}
+	}
+	return false
+}
+
+// isImmutableFieldError returns whether or not the supplied apply error was caused by a change of
+// an immutable field, which cannot be applied in place
+func isImmutableFieldError(err error) bool {
+	message := err.Error()
+	return strings.Contains(message, "field is immutable") ||
+		strings.Contains(message, "may not change once set") ||
+		(strings.Contains(message, "Forbidden: updates to") && strings.Contains(message, "are forbidden"))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -440,6 +441,177 @@ func TestDontSyncOrPruneHooks(t *testing.T) {
 	assert.Equal(t, syncCtx.opState.Phase, v1alpha1.OperationSucceeded)
 }
 
+func TestSyncWaves(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	svc := test.NewService()
+	pod := test.NewPod()
+	pod.SetAnnotations(map[string]string{common.AnnotationKeySyncWave: "1"})
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   nil,
+			Target: pod,
+		}, {
+			Live:   nil,
+			Target: svc,
+		}},
+	}
+	// wave 0 is applied first
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "Service", syncCtx.syncRes.Resources[0].Kind)
+
+	// wave 1 is not started until wave 0 is healthy
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Contains(t, syncCtx.opState.Message, "waiting for wave 0")
+
+	syncCtx.compareResult.managedResources[1].Live = svc
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+}
+
+func TestSyncInvalidWave(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	pod := test.NewPod()
+	pod.SetAnnotations(map[string]string{common.AnnotationKeySyncWave: "first"})
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   nil,
+			Target: pod,
+		}},
+	}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	assert.Contains(t, syncCtx.syncRes.Resources[0].Message, common.AnnotationKeySyncWave)
+}
+
+func TestSyncOptionPruneDisabled(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	pod := test.NewPod()
+	pod.SetAnnotations(map[string]string{common.AnnotationKeySyncOptions: "Prune=false"})
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   pod,
+			Target: nil,
+		}},
+	}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.ResultCodePruneSkipped, syncCtx.syncRes.Resources[0].Status)
+	assert.Contains(t, syncCtx.syncRes.Resources[0].Message, "Prune=false")
+}
+
+func TestSyncOptionSkipDryRunAndValidate(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = kubetest.MockKubectlCmd{
+		Commands: map[string]kubetest.KubectlOutput{
+			"my-pod": {
+				Output: "pod/my-pod created",
+			},
+		},
+	}
+	pod := test.NewPod()
+	pod.SetAnnotations(map[string]string{common.AnnotationKeySyncOptions: "SkipDryRun=true, Validate=false"})
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   nil,
+			Target: pod,
+		}},
+	}
+	tasks, successful := syncCtx.generateSyncTasks()
+	assert.True(t, successful)
+	assert.Len(t, tasks, 1)
+	assert.True(t, tasks[0].skipDryRun)
+
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.ResultCodeSynced, syncCtx.syncRes.Resources[0].Status)
+	assert.Equal(t, "pod/my-pod created. validation skipped (Validate=false), dry run skipped (SkipDryRun=true)", syncCtx.syncRes.Resources[0].Message)
+}
+
+func TestSyncOptionCreateOnly(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = kubetest.MockKubectlCmd{
+		Commands: map[string]kubetest.KubectlOutput{
+			"my-pod": {
+				Err: fmt.Errorf("should not be applied"),
+			},
+		},
+	}
+	pod := test.NewPod()
+	pod.SetAnnotations(map[string]string{common.AnnotationKeySyncOptions: "CreateOnly=true"})
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   test.NewPod(),
+			Target: pod,
+		}},
+	}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.ResultCodeSynced, syncCtx.syncRes.Resources[0].Status)
+	assert.Contains(t, syncCtx.syncRes.Resources[0].Message, "CreateOnly=true")
+}
+
+func TestSyncImmutableFieldError(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = kubetest.MockKubectlCmd{
+		Commands: map[string]kubetest.KubectlOutput{
+			"my-service": {
+				Err: fmt.Errorf(`The Service "my-service" is invalid: spec.clusterIP: Invalid value: "": field is immutable`),
+			},
+		},
+	}
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   test.NewService(),
+			Target: test.NewService(),
+		}},
+	}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.ResultCodeSyncFailed, syncCtx.syncRes.Resources[0].Status)
+	assert.Contains(t, syncCtx.syncRes.Resources[0].Message, "field is immutable")
+	assert.Contains(t, syncCtx.syncRes.Resources[0].Message, "Replace=true")
+	assert.Contains(t, syncCtx.syncRes.Resources[0].Message, "CreateOnly=true")
+}
+
+func TestSyncWaitForHealth(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.syncOp.HealthCheck = &v1alpha1.SyncHealthCheck{Timeout: "1m"}
+	pod := test.NewPod()
+	livePod := pod.DeepCopy()
+	_ = unstructured.SetNestedField(livePod.Object, "Pending", "status", "phase")
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   livePod,
+			Target: pod,
+		}},
+	}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+
+	// the operation keeps running until the pod is healthy
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Contains(t, syncCtx.opState.Message, "waiting for resources to become Healthy")
+	assert.NotNil(t, syncCtx.syncRes.HealthCheckStartedAt)
+
+	// the operation fails once the timeout elapsed
+	startedAt := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	syncCtx.syncRes.HealthCheckStartedAt = &startedAt
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	assert.Contains(t, syncCtx.opState.Message, "Pod/my-pod health: Progressing")
+
+	syncCtx.syncRes.HealthCheckStartedAt = nil
+	_ = unstructured.SetNestedField(livePod.Object, "Succeeded", "status", "phase")
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+}
+
 func TestPersistRevisionHistory(t *testing.T) {
 	app := newFakeApp()
 	app.Status.OperationState = nil
@@ -464,9 +636,13 @@ func TestPersistRevisionHistory(t *testing.T) {
 	ctrl := newFakeController(&data)
 
 	// Sync with source unspecified
-	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
-		Sync: &v1alpha1.SyncOperation{},
-	}}
+	opState := &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{
+			Sync:        &v1alpha1.SyncOperation{},
+			InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"},
+		},
+		StartedAt: v1.Now(),
+	}
 	ctrl.appStateManager.SyncAppState(app, opState)
 	// Ensure we record spec.source into sync result
 	assert.Equal(t, app.Spec.Source, opState.SyncResult.Source)
@@ -476,6 +652,85 @@ func TestPersistRevisionHistory(t *testing.T) {
 	assert.Equal(t, 1, len(updatedApp.Status.History))
 	assert.Equal(t, app.Spec.Source, updatedApp.Status.History[0].Source)
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
+	assert.Equal(t, "admin", updatedApp.Status.History[0].InitiatedBy.Username)
+	assert.NotNil(t, updatedApp.Status.History[0].DeployStartedAt)
+}
+
+func TestPersistRevisionHistoryLimit(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	for i := 0; i < 3; i++ {
+		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{ID: int64(i), Revision: fmt.Sprintf("rev%d", i)})
+	}
+	limit := int64(2)
+	app.Spec.RevisionHistoryLimit = &limit
+
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &repository.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync:        &v1alpha1.SyncOperation{},
+		InitiatedBy: v1alpha1.OperationInitiator{Automated: true},
+	}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, updatedApp.Status.History, 2)
+	assert.Equal(t, int64(2), updatedApp.Status.History[0].ID)
+	assert.Equal(t, int64(3), updatedApp.Status.History[1].ID)
+	assert.Equal(t, "automated", updatedApp.Status.History[1].InitiatedBy.String())
+}
+
+func TestPersistRevisionHistoryNegativeLimit(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = []v1alpha1.RevisionHistory{{ID: 0, Revision: "rev0"}}
+	limit := int64(-1)
+	app.Spec.RevisionHistoryLimit = &limit
+
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &repository.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+
+	// a negative limit keeps no history instead of failing the sync
+	assert.Equal(t, v1alpha1.OperationSucceeded, opState.Phase)
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, updatedApp.Status.History, 0)
 }
 
 func TestPersistRevisionHistoryRollback(t *testing.T) {
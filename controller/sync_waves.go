@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/health"
+)
+
+// syncWave returns the sync wave of the supplied object, as specified by the sync-wave annotation.
+// Objects without the annotation belong to wave 0.
+func syncWave(obj *unstructured.Unstructured) (int, error) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		return 0, nil
+	}
+	waveStr := strings.TrimSpace(annotations[common.AnnotationKeySyncWave])
+	if waveStr == "" {
+		return 0, nil
+	}
+	wave, err := strconv.Atoi(waveStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s annotation '%s': must be an integer", common.AnnotationKeySyncWave, waveStr)
+	}
+	return wave, nil
+}
+
+// getWaves returns the distinct sync waves of the supplied tasks and hooks in ascending order
+func getWaves(syncTasks []syncTask, hooks []*unstructured.Unstructured) []int {
+	seen := make(map[int]bool)
+	for _, task := range syncTasks {
+		seen[task.wave] = true
+	}
+	for _, hook := range hooks {
+		wave, _ := syncWave(hook)
+		seen[wave] = true
+	}
+	waves := make([]int, 0, len(seen))
+	for wave := range seen {
+		waves = append(waves, wave)
+	}
+	sort.Ints(waves)
+	return waves
+}
+
+// tasksOfWave filters the sync tasks which belong to the specified wave
+func tasksOfWave(syncTasks []syncTask, wave int) []syncTask {
+	var tasks []syncTask
+	for _, task := range syncTasks {
+		if task.wave == wave {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks
+}
+
+// isPhaseHook returns whether the hook belongs to the phase of the specified hook type. Hooks
+// annotated with Skip belong to the Sync phase, so that they are reported as skipped.
+func isPhaseHook(hook *unstructured.Unstructured, hookType appv1.HookType) bool {
+	if hookType == appv1.HookTypeSync && isHookType(hook, appv1.HookTypeSkip) {
+		return true
+	}
+	return isHookType(hook, hookType)
+}
+
+// hooksOfWave filters the hooks of the phase of the specified type which belong to the specified wave
+func hooksOfWave(hooks []*unstructured.Unstructured, hookType appv1.HookType, wave int) []*unstructured.Unstructured {
+	var waveHooks []*unstructured.Unstructured
+	for _, hook := range hooks {
+		if !isPhaseHook(hook, hookType) {
+			continue
+		}
+		if hookWave, _ := syncWave(hook); hookWave == wave {
+			waveHooks = append(waveHooks, hook)
+		}
+	}
+	return waveHooks
+}
+
+// runWaves applies the sync tasks and runs the hooks of the specified type wave by wave, in
+// ascending wave order. The next wave is only started after all resources of the previous wave
+// were applied and became healthy, and all its hooks completed successfully. This method is invoked
+// on every reconciliation of an in-flight operation and should be idempotent. Returns true once all
+// waves have completed.
+func (sc *syncContext) runWaves(syncTasks []syncTask, hooks []*unstructured.Unstructured, hookType appv1.HookType, force bool) bool {
+	var typedHooks []*unstructured.Unstructured
+	for _, hook := range hooks {
+		if isPhaseHook(hook, hookType) {
+			typedHooks = append(typedHooks, hook)
+		}
+	}
+	waves := getWaves(syncTasks, typedHooks)
+	for i, wave := range waves {
+		waveTasks := tasksOfWave(syncTasks, wave)
+		waveHooks := hooksOfWave(typedHooks, hookType, wave)
+
+		applied := false
+		if len(waveTasks) > 0 && !sc.areTasksApplied(waveTasks) {
+			if !sc.doApplySync(waveTasks, false, force, true) {
+				sc.setOperationPhase(appv1.OperationFailed, "one or more objects failed to apply")
+				return false
+			}
+			applied = true
+		}
+		if len(waveHooks) > 0 && !sc.runHooks(waveHooks, hookType) {
+			return false
+		}
+		if applied {
+			// If apply was successful, return here and force an app refresh. This is so the app
+			// will become requeued into the workqueue, to force a new sync/health assessment before
+			// proceeding to the next wave or marking the operation as completed
+			return false
+		}
+		if i < len(waves)-1 {
+			if healthy, message := sc.areTasksHealthy(waveTasks); !healthy {
+				sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("waiting for wave %d to become %s before starting wave %d (%s)",
+					wave, appv1.HealthStatusHealthy, waves[i+1], message))
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// areTasksApplied returns whether all of the supplied tasks already have a recorded result
+func (sc *syncContext) areTasksApplied(syncTasks []syncTask) bool {
+	for _, task := range syncTasks {
+		obj := task.targetObj
+		if obj == nil {
+			obj = task.liveObj
+		}
+		if sc.getResourceResult(obj) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// getResourceResult returns the recorded result of a non-hook resource in the SyncResult.Resources list
+func (sc *syncContext) getResourceResult(obj *unstructured.Unstructured) *appv1.ResourceResult {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+	gvk := obj.GroupVersionKind()
+	for _, res := range sc.syncRes.Resources {
+		if res.IsHook() {
+			continue
+		}
+		if res.Group == gvk.Group && res.Kind == obj.GetKind() && res.Namespace == obj.GetNamespace() && res.Name == obj.GetName() {
+			return res
+		}
+	}
+	return nil
+}
+
+// areTasksHealthy returns whether all applied resources of the supplied tasks are healthy. If not,
+// also returns a message describing the first resource which is not.
+func (sc *syncContext) areTasksHealthy(syncTasks []syncTask) (bool, string) {
+	for _, task := range syncTasks {
+		if task.targetObj == nil {
+			// pruned objects do not have to become healthy
+			continue
+		}
+		if task.liveObj == nil {
+			return false, fmt.Sprintf("%s/%s health: %s", task.targetObj.GetKind(), task.targetObj.GetName(), appv1.HealthStatusMissing)
+		}
+		resHealth, err := health.GetResourceHealth(task.liveObj, sc.resourceOverrides)
+		if err != nil {
+			return false, fmt.Sprintf("%s/%s health: %v", task.liveObj.GetKind(), task.liveObj.GetName(), err)
+		}
+		if resHealth.Status != appv1.HealthStatusHealthy {
+			return false, fmt.Sprintf("%s/%s health: %s", task.liveObj.GetKind(), task.liveObj.GetName(), resHealth.Status)
+		}
+	}
+	return true, ""
+}
@@ -562,10 +562,38 @@ func (m *ApplicationWatchEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 
+func (m *Backoff) Reset()      { *m = Backoff{} }
+func (*Backoff) ProtoMessage() {}
+func (*Backoff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{19}
+}
+func (m *Backoff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Backoff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *Backoff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Backoff.Merge(dst, src)
+}
+func (m *Backoff) XXX_Size() int {
+	return m.Size()
+}
+func (m *Backoff) XXX_DiscardUnknown() {
+	xxx_messageInfo_Backoff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Backoff proto.InternalMessageInfo
+
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{19}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{20}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,10 +618,38 @@ func (m *Cluster) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Cluster proto.InternalMessageInfo
 
+func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
+func (*ClusterCacheInfo) ProtoMessage() {}
+func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{21}
+}
+func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterCacheInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ClusterCacheInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCacheInfo.Merge(dst, src)
+}
+func (m *ClusterCacheInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterCacheInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCacheInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
+
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{20}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{22}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,10 +674,38 @@ func (m *ClusterConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 
+func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
+func (*ClusterInfo) ProtoMessage() {}
+func (*ClusterInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{23}
+}
+func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ClusterInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterInfo.Merge(dst, src)
+}
+func (m *ClusterInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
+
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{21}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{24}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +733,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{22}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{25}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +761,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{23}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{26}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -705,7 +789,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{24}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{27}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +817,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{25}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{28}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +845,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{26}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{29}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,10 +870,38 @@ func (m *ConnectionState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 
+func (m *DeletionHooksState) Reset()      { *m = DeletionHooksState{} }
+func (*DeletionHooksState) ProtoMessage() {}
+func (*DeletionHooksState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{30}
+}
+func (m *DeletionHooksState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletionHooksState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *DeletionHooksState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletionHooksState.Merge(dst, src)
+}
+func (m *DeletionHooksState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletionHooksState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletionHooksState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletionHooksState proto.InternalMessageInfo
+
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{27}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{31}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +929,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{28}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{32}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -845,7 +957,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *HelmRepository) Reset()      { *m = HelmRepository{} }
 func (*HelmRepository) ProtoMessage() {}
 func (*HelmRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{29}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{33}
 }
 func (m *HelmRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -873,7 +985,7 @@ var xxx_messageInfo_HelmRepository proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{30}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{34}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,7 +1013,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{31}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{35}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,7 +1041,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{32}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{36}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -957,7 +1069,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KsonnetParameter) Reset()      { *m = KsonnetParameter{} }
 func (*KsonnetParameter) ProtoMessage() {}
 func (*KsonnetParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{33}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{37}
 }
 func (m *KsonnetParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1097,7 @@ var xxx_messageInfo_KsonnetParameter proto.InternalMessageInfo
 func (m *KustomizeImageTag) Reset()      { *m = KustomizeImageTag{} }
 func (*KustomizeImageTag) ProtoMessage() {}
 func (*KustomizeImageTag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{34}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{38}
 }
 func (m *KustomizeImageTag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1125,7 @@ var xxx_messageInfo_KustomizeImageTag proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{35}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{39}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1038,10 +1150,38 @@ func (m *Operation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Operation proto.InternalMessageInfo
 
+func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
+func (*OperationInitiator) ProtoMessage() {}
+func (*OperationInitiator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{40}
+}
+func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationInitiator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *OperationInitiator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationInitiator.Merge(dst, src)
+}
+func (m *OperationInitiator) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationInitiator) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationInitiator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
+
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{36}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{41}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,10 +1206,94 @@ func (m *OperationState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OperationState proto.InternalMessageInfo
 
+func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
+func (*OrphanedResourceKey) ProtoMessage() {}
+func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{42}
+}
+func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResourceKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *OrphanedResourceKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResourceKey.Merge(dst, src)
+}
+func (m *OrphanedResourceKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResourceKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResourceKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
+
+func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
+func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
+func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{43}
+}
+func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResourcesMonitorSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *OrphanedResourcesMonitorSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResourcesMonitorSettings.Merge(dst, src)
+}
+func (m *OrphanedResourcesMonitorSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResourcesMonitorSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResourcesMonitorSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
+
+func (m *PendingOperation) Reset()      { *m = PendingOperation{} }
+func (*PendingOperation) ProtoMessage() {}
+func (*PendingOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{44}
+}
+func (m *PendingOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *PendingOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOperation.Merge(dst, src)
+}
+func (m *PendingOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOperation proto.InternalMessageInfo
+
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{37}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{45}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1097,7 +1321,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{38}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{46}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,7 +1349,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{39}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{47}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,7 +1377,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{40}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{48}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1181,7 +1405,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{41}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{49}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1433,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{42}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{50}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,7 +1461,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{43}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{51}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1265,7 +1489,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{44}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{52}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1517,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{45}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{53}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,7 +1545,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{46}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{54}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1349,7 +1573,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{47}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{55}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1374,10 +1598,38 @@ func (m *ResourceStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 
+func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
+func (*RetryStrategy) ProtoMessage() {}
+func (*RetryStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{56}
+}
+func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *RetryStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryStrategy.Merge(dst, src)
+}
+func (m *RetryStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
+
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{48}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{57}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,10 +1654,38 @@ func (m *RevisionHistory) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 
+func (m *SyncHealthCheck) Reset()      { *m = SyncHealthCheck{} }
+func (*SyncHealthCheck) ProtoMessage() {}
+func (*SyncHealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{58}
+}
+func (m *SyncHealthCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncHealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncHealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncHealthCheck.Merge(dst, src)
+}
+func (m *SyncHealthCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncHealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncHealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncHealthCheck proto.InternalMessageInfo
+
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{49}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{59}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1713,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{50}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{60}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1461,7 +1741,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{51}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{61}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1489,7 +1769,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{52}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{62}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1517,7 +1797,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{53}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{63}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1542,10 +1822,38 @@ func (m *SyncPolicyAutomated) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 
+func (m *SyncSchedule) Reset()      { *m = SyncSchedule{} }
+func (*SyncSchedule) ProtoMessage() {}
+func (*SyncSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{64}
+}
+func (m *SyncSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncSchedule.Merge(dst, src)
+}
+func (m *SyncSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncSchedule proto.InternalMessageInfo
+
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{54}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{65}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1573,7 +1881,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{55}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{66}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1601,7 +1909,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{56}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{67}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1629,7 +1937,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{57}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{68}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1654,10 +1962,38 @@ func (m *SyncStrategyHook) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 
-func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
+func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
+func (*SyncWindow) ProtoMessage() {}
+func (*SyncWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_090fe54925d89cd3, []int{69}
+}
+func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SyncWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindow.Merge(dst, src)
+}
+func (m *SyncWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
+
+func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_090fe54925d89cd3, []int{58}
+	return fileDescriptor_generated_090fe54925d89cd3, []int{70}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1702,14 +2038,18 @@ func init() {
 	proto.RegisterType((*ApplicationStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationStatus")
 	proto.RegisterType((*ApplicationTree)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationTree")
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
+	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Backoff")
 	proto.RegisterType((*Cluster)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Cluster")
+	proto.RegisterType((*ClusterCacheInfo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterCacheInfo")
 	proto.RegisterType((*ClusterConfig)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterConfig")
+	proto.RegisterType((*ClusterInfo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterInfo")
 	proto.RegisterType((*ClusterList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterList")
 	proto.RegisterType((*Command)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Command")
 	proto.RegisterType((*ComparedTo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ComparedTo")
 	proto.RegisterType((*ComponentParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ComponentParameter")
 	proto.RegisterType((*ConfigManagementPlugin)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ConfigManagementPlugin")
 	proto.RegisterType((*ConnectionState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ConnectionState")
+	proto.RegisterType((*DeletionHooksState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.DeletionHooksState")
 	proto.RegisterType((*HealthStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HealthStatus")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HelmParameter")
 	proto.RegisterType((*HelmRepository)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HelmRepository")
@@ -1719,7 +2059,11 @@ func init() {
 	proto.RegisterType((*KsonnetParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.KsonnetParameter")
 	proto.RegisterType((*KustomizeImageTag)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.KustomizeImageTag")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Operation")
+	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OperationInitiator")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OperationState")
+	proto.RegisterType((*OrphanedResourceKey)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OrphanedResourceKey")
+	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
+	proto.RegisterType((*PendingOperation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.PendingOperation")
 	proto.RegisterType((*ProjectRole)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ProjectRole")
 	proto.RegisterType((*Repository)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Repository")
 	proto.RegisterType((*RepositoryList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepositoryList")
@@ -1733,16 +2077,20 @@ func init() {
 	proto.RegisterType((*ResourceRef)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceRef")
 	proto.RegisterType((*ResourceResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceResult")
 	proto.RegisterType((*ResourceStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceStatus")
+	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RetryStrategy")
 	proto.RegisterType((*RevisionHistory)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RevisionHistory")
+	proto.RegisterType((*SyncHealthCheck)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncHealthCheck")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperationResult")
 	proto.RegisterType((*SyncPolicy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncPolicy")
 	proto.RegisterType((*SyncPolicyAutomated)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncPolicyAutomated")
+	proto.RegisterType((*SyncSchedule)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncSchedule")
 	proto.RegisterType((*SyncStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStatus")
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategyHook")
+	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncWindow")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.TLSClientConfig")
 }
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -1925,6 +2273,36 @@ func (m *AppProjectSpec) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.SyncWindows) > 0 {
+		for _, msg := range m.SyncWindows {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x40
+	i++
+	if m.AutoSyncDisabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.OrphanedResources != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.OrphanedResources.Size()))
+		n4, err := m.OrphanedResources.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
 	return i, nil
 }
 
@@ -1946,36 +2324,48 @@ func (m *Application) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ObjectMeta.Size()))
-	n4, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	n5, err := m.ObjectMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Spec.Size()))
-	n5, err := m.Spec.MarshalTo(dAtA[i:])
+	n6, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Status.Size()))
-	n6, err := m.Status.MarshalTo(dAtA[i:])
+	n7, err := m.Status.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
 	if m.Operation != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Operation.Size()))
-		n7, err := m.Operation.MarshalTo(dAtA[i:])
+		n8, err := m.Operation.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
+	}
+	if len(m.PendingOperations) > 0 {
+		for _, msg := range m.PendingOperations {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
@@ -2050,11 +2440,11 @@ func (m *ApplicationList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n8, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n9, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -2101,51 +2491,51 @@ func (m *ApplicationSource) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Helm.Size()))
-		n9, err := m.Helm.MarshalTo(dAtA[i:])
+		n10, err := m.Helm.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
 	}
 	if m.Kustomize != nil {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Kustomize.Size()))
-		n10, err := m.Kustomize.MarshalTo(dAtA[i:])
+		n11, err := m.Kustomize.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	if m.Ksonnet != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Ksonnet.Size()))
-		n11, err := m.Ksonnet.MarshalTo(dAtA[i:])
+		n12, err := m.Ksonnet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n12
 	}
 	if m.Directory != nil {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Directory.Size()))
-		n12, err := m.Directory.MarshalTo(dAtA[i:])
+		n13, err := m.Directory.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	if m.Plugin != nil {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Plugin.Size()))
-		n13, err := m.Plugin.MarshalTo(dAtA[i:])
+		n14, err := m.Plugin.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	return i, nil
 }
@@ -2176,11 +2566,11 @@ func (m *ApplicationSourceDirectory) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Jsonnet.Size()))
-	n14, err := m.Jsonnet.MarshalTo(dAtA[i:])
+	n15, err := m.Jsonnet.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	return i, nil
 }
 
@@ -2394,19 +2784,19 @@ func (m *ApplicationSpec) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n15, err := m.Source.MarshalTo(dAtA[i:])
+	n16, err := m.Source.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n16
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Destination.Size()))
-	n16, err := m.Destination.MarshalTo(dAtA[i:])
+	n17, err := m.Destination.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n17
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Project)))
@@ -2415,11 +2805,11 @@ func (m *ApplicationSpec) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncPolicy.Size()))
-		n17, err := m.SyncPolicy.MarshalTo(dAtA[i:])
+		n18, err := m.SyncPolicy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n18
 	}
 	if len(m.IgnoreDifferences) > 0 {
 		for _, msg := range m.IgnoreDifferences {
@@ -2433,6 +2823,11 @@ func (m *ApplicationSpec) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.RevisionHistoryLimit != nil {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
+	}
 	return i, nil
 }
 
@@ -2466,19 +2861,19 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Sync.Size()))
-	n18, err := m.Sync.MarshalTo(dAtA[i:])
+	n19, err := m.Sync.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n19
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-	n19, err := m.Health.MarshalTo(dAtA[i:])
+	n20, err := m.Health.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n20
 	if len(m.History) > 0 {
 		for _, msg := range m.History {
 			dAtA[i] = 0x22
@@ -2506,29 +2901,29 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ReconciledAt.Size()))
-	n20, err := m.ReconciledAt.MarshalTo(dAtA[i:])
+	n21, err := m.ReconciledAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n21
 	if m.OperationState != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.OperationState.Size()))
-		n21, err := m.OperationState.MarshalTo(dAtA[i:])
+		n22, err := m.OperationState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	dAtA[i] = 0x42
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedAt.Size()))
-	n22, err := m.ObservedAt.MarshalTo(dAtA[i:])
+	n23, err := m.ObservedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n23
 	dAtA[i] = 0x4a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SourceType)))
@@ -2545,6 +2940,41 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.DeletionHookTypes) > 0 {
+		for _, s := range m.DeletionHookTypes {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.DeletionHooksState != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.DeletionHooksState.Size()))
+		n24, err := m.DeletionHooksState.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.LastScheduledSyncAt != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.LastScheduledSyncAt.Size()))
+		n25, err := m.LastScheduledSyncAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
 	return i, nil
 }
 
@@ -2575,6 +3005,18 @@ func (m *ApplicationTree) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.OrphanedNodes) > 0 {
+		for _, msg := range m.OrphanedNodes {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -2600,11 +3042,40 @@ func (m *ApplicationWatchEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Application.Size()))
-	n23, err := m.Application.MarshalTo(dAtA[i:])
+	n26, err := m.Application.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n26
+	return i, nil
+}
+
+func (m *Backoff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Backoff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i += copy(dAtA[i:], m.Duration)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Factor))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxDuration)))
+	i += copy(dAtA[i:], m.MaxDuration)
 	return i, nil
 }
 
@@ -2634,19 +3105,85 @@ func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Config.Size()))
-	n24, err := m.Config.MarshalTo(dAtA[i:])
+	n27, err := m.Config.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n27
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ConnectionState.Size()))
-	n25, err := m.ConnectionState.MarshalTo(dAtA[i:])
+	n28, err := m.ConnectionState.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
+	if m.Shard != nil {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Shard))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Info.Size()))
+	n29, err := m.Info.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n29
+	return i, nil
+}
+
+func (m *ClusterCacheInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCacheInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ResourcesCount))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.APIsCount))
+	if m.LastCacheSyncTime != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.LastCacheSyncTime.Size()))
+		n30, err := m.LastCacheSyncTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncError)))
+	i += copy(dAtA[i:], m.SyncError)
 	return i, nil
 }
 
@@ -2680,21 +3217,54 @@ func (m *ClusterConfig) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.TLSClientConfig.Size()))
-	n26, err := m.TLSClientConfig.MarshalTo(dAtA[i:])
+	n31, err := m.TLSClientConfig.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n31
 	if m.AWSAuthConfig != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.AWSAuthConfig.Size()))
-		n27, err := m.AWSAuthConfig.MarshalTo(dAtA[i:])
+		n32, err := m.AWSAuthConfig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n32
+	}
+	return i, nil
+}
+
+func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerVersion)))
+	i += copy(dAtA[i:], m.ServerVersion)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CacheInfo.Size()))
+	n33, err := m.CacheInfo.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n33
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ApplicationsCount))
 	return i, nil
 }
 
@@ -2716,11 +3286,11 @@ func (m *ClusterList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n28, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n34, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n28
+	i += n34
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -2802,19 +3372,19 @@ func (m *ComparedTo) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n29, err := m.Source.MarshalTo(dAtA[i:])
+	n35, err := m.Source.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n35
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Destination.Size()))
-	n30, err := m.Destination.MarshalTo(dAtA[i:])
+	n36, err := m.Destination.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n30
+	i += n36
 	return i, nil
 }
 
@@ -2871,20 +3441,20 @@ func (m *ConfigManagementPlugin) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Init.Size()))
-		n31, err := m.Init.MarshalTo(dAtA[i:])
+		n37, err := m.Init.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n37
 	}
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Generate.Size()))
-	n32, err := m.Generate.MarshalTo(dAtA[i:])
+	n38, err := m.Generate.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n38
 	return i, nil
 }
 
@@ -2915,16 +3485,16 @@ func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.ModifiedAt.Size()))
-		n33, err := m.ModifiedAt.MarshalTo(dAtA[i:])
+		n39, err := m.ModifiedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n39
 	}
 	return i, nil
 }
 
-func (m *HealthStatus) Marshal() (dAtA []byte, err error) {
+func (m *DeletionHooksState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2934,23 +3504,57 @@ func (m *HealthStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HealthStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeletionHooksState) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
-	i += copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i += copy(dAtA[i:], m.Phase)
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
+	if len(m.Resources) > 0 {
+		for _, msg := range m.Resources {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
+	n40, err := m.StartedAt.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n40
+	if m.FinishedAt != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.FinishedAt.Size()))
+		n41, err := m.FinishedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
 	return i, nil
 }
 
-func (m *HelmParameter) Marshal() (dAtA []byte, err error) {
+func (m *HealthStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2960,19 +3564,45 @@ func (m *HelmParameter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HelmParameter) MarshalTo(dAtA []byte) (int, error) {
+func (m *HealthStatus) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i += copy(dAtA[i:], m.Status)
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
-	i += copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	return i, nil
+}
+
+func (m *HelmParameter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmParameter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i += copy(dAtA[i:], m.Value)
 	return i, nil
 }
 
@@ -3187,12 +3817,50 @@ func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Sync.Size()))
-		n34, err := m.Sync.MarshalTo(dAtA[i:])
+		n42, err := m.Sync.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n42
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.InitiatedBy.Size()))
+	n43, err := m.InitiatedBy.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n43
+	return i, nil
+}
+
+func (m *OperationInitiator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationInitiator) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i += copy(dAtA[i:], m.Username)
+	dAtA[i] = 0x10
+	i++
+	if m.Automated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -3214,11 +3882,11 @@ func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Operation.Size()))
-	n35, err := m.Operation.MarshalTo(dAtA[i:])
+	n44, err := m.Operation.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n35
+	i += n44
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
@@ -3231,30 +3899,141 @@ func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncResult.Size()))
-		n36, err := m.SyncResult.MarshalTo(dAtA[i:])
+		n45, err := m.SyncResult.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n45
 	}
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
-	n37, err := m.StartedAt.MarshalTo(dAtA[i:])
+	n46, err := m.StartedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n46
 	if m.FinishedAt != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.FinishedAt.Size()))
-		n38, err := m.FinishedAt.MarshalTo(dAtA[i:])
+		n47, err := m.FinishedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n47
+	}
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
+	return i, nil
+}
+
+func (m *OrphanedResourceKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourceKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	return i, nil
+}
+
+func (m *OrphanedResourcesMonitorSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourcesMonitorSettings) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Warn != nil {
+		dAtA[i] = 0x8
+		i++
+		if *m.Warn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Ignore) > 0 {
+		for _, msg := range m.Ignore {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PendingOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingOperation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i += copy(dAtA[i:], m.ID)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Operation.Size()))
+	n48, err := m.Operation.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n48
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.QueuedAt.Size()))
+	n49, err := m.QueuedAt.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n49
 	return i, nil
 }
 
@@ -3360,11 +4139,11 @@ func (m *Repository) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ConnectionState.Size()))
-	n39, err := m.ConnectionState.MarshalTo(dAtA[i:])
+	n50, err := m.ConnectionState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n39
+	i += n50
 	dAtA[i] = 0x30
 	i++
 	if m.InsecureIgnoreHostKey {
@@ -3394,11 +4173,11 @@ func (m *RepositoryList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n40, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n51, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n51
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -3613,11 +4392,11 @@ func (m *ResourceNode) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ResourceRef.Size()))
-	n41, err := m.ResourceRef.MarshalTo(dAtA[i:])
+	n52, err := m.ResourceRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
+	i += n52
 	if len(m.ParentRefs) > 0 {
 		for _, msg := range m.ParentRefs {
 			dAtA[i] = 0x12
@@ -3646,11 +4425,11 @@ func (m *ResourceNode) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.NetworkingInfo.Size()))
-		n42, err := m.NetworkingInfo.MarshalTo(dAtA[i:])
+		n53, err := m.NetworkingInfo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n53
 	}
 	dAtA[i] = 0x2a
 	i++
@@ -3789,6 +4568,14 @@ func (m *ResourceResult) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HookPhase)))
 	i += copy(dAtA[i:], m.HookPhase)
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UID)))
+	i += copy(dAtA[i:], m.UID)
+	dAtA[i] = 0x5a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GenerateName)))
+	i += copy(dAtA[i:], m.GenerateName)
 	return i, nil
 }
 
@@ -3834,11 +4621,11 @@ func (m *ResourceStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x3a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-	n43, err := m.Health.MarshalTo(dAtA[i:])
+	n54, err := m.Health.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n43
+	i += n54
 	dAtA[i] = 0x40
 	i++
 	if m.Hook {
@@ -3850,6 +4637,37 @@ func (m *ResourceStatus) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	if m.Backoff != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Backoff.Size()))
+		n55, err := m.Backoff.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	return i, nil
+}
+
 func (m *RevisionHistory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3872,22 +4690,74 @@ func (m *RevisionHistory) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.DeployedAt.Size()))
-	n44, err := m.DeployedAt.MarshalTo(dAtA[i:])
+	n56, err := m.DeployedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n44
+	i += n56
 	dAtA[i] = 0x28
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n45, err := m.Source.MarshalTo(dAtA[i:])
+	n57, err := m.Source.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n57
+	if m.DeployStartedAt != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.DeployStartedAt.Size()))
+		n58, err := m.DeployStartedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.InitiatedBy.Size()))
+	n59, err := m.InitiatedBy.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n45
+	i += n59
+	if len(m.Resources) > 0 {
+		for _, msg := range m.Resources {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SyncHealthCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncHealthCheck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i += copy(dAtA[i:], m.Timeout)
 	return i, nil
 }
 
@@ -3930,11 +4800,11 @@ func (m *SyncOperation) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategy.Size()))
-		n46, err := m.SyncStrategy.MarshalTo(dAtA[i:])
+		n60, err := m.SyncStrategy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n60
 	}
 	if len(m.Resources) > 0 {
 		for _, msg := range m.Resources {
@@ -3952,12 +4822,36 @@ func (m *SyncOperation) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-		n47, err := m.Source.MarshalTo(dAtA[i:])
+		n61, err := m.Source.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n61
+	}
+	if m.Retry != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Retry.Size()))
+		n62, err := m.Retry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
+	}
+	if m.HealthCheck != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.HealthCheck.Size()))
+		n63, err := m.HealthCheck.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
 	}
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i += copy(dAtA[i:], m.Timeout)
 	return i, nil
 }
 
@@ -4025,11 +4919,21 @@ func (m *SyncOperationResult) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n48, err := m.Source.MarshalTo(dAtA[i:])
+	n64, err := m.Source.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n48
+	i += n64
+	if m.HealthCheckStartedAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.HealthCheckStartedAt.Size()))
+		n65, err := m.HealthCheckStartedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
+	}
 	return i, nil
 }
 
@@ -4052,23 +4956,57 @@ func (m *SyncPolicy) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Automated.Size()))
-		n49, err := m.Automated.MarshalTo(dAtA[i:])
+		n66, err := m.Automated.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
-	}
-	return i, nil
-}
-
-func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		i += n66
 	}
-	return dAtA[:n], nil
+	if m.Retry != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Retry.Size()))
+		n67, err := m.Retry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
+	}
+	if m.HealthCheck != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.HealthCheck.Size()))
+		n68, err := m.HealthCheck.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
+	}
+	if m.Schedule != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Schedule.Size()))
+		n69, err := m.Schedule.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FieldManager)))
+	i += copy(dAtA[i:], m.FieldManager)
+	return i, nil
+}
+
+func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *SyncPolicyAutomated) MarshalTo(dAtA []byte) (int, error) {
@@ -4084,6 +5022,50 @@ func (m *SyncPolicyAutomated) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0
 	}
 	i++
+	dAtA[i] = 0x10
+	i++
+	if m.SelfHeal {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PruneLimit))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PrunePercentLimit))
+	return i, nil
+}
+
+func (m *SyncSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncSchedule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cron)))
+	i += copy(dAtA[i:], m.Cron)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i += copy(dAtA[i:], m.TimeZone)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i += copy(dAtA[i:], m.Duration)
 	return i, nil
 }
 
@@ -4109,11 +5091,11 @@ func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ComparedTo.Size()))
-	n50, err := m.ComparedTo.MarshalTo(dAtA[i:])
+	n70, err := m.ComparedTo.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n50
+	i += n70
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
@@ -4140,21 +5122,21 @@ func (m *SyncStrategy) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Apply.Size()))
-		n51, err := m.Apply.MarshalTo(dAtA[i:])
+		n71, err := m.Apply.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n71
 	}
 	if m.Hook != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Hook.Size()))
-		n52, err := m.Hook.MarshalTo(dAtA[i:])
+		n72, err := m.Hook.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n72
 	}
 	return i, nil
 }
@@ -4182,6 +5164,18 @@ func (m *SyncStrategyApply) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0
 	}
 	i++
+	dAtA[i] = 0x10
+	i++
+	if m.ServerSideApply {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FieldManager)))
+	i += copy(dAtA[i:], m.FieldManager)
 	return i, nil
 }
 
@@ -4203,11 +5197,98 @@ func (m *SyncStrategyHook) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategyApply.Size()))
-	n53, err := m.SyncStrategyApply.MarshalTo(dAtA[i:])
+	n73, err := m.SyncStrategyApply.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n53
+	i += n73
+	return i, nil
+}
+
+func (m *SyncWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindow) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i += copy(dAtA[i:], m.Schedule)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i += copy(dAtA[i:], m.Duration)
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x38
+	i++
+	if m.ManualSync {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i += copy(dAtA[i:], m.TimeZone)
 	return i, nil
 }
 
@@ -4337,6 +5418,17 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.SyncWindows) > 0 {
+		for _, e := range m.SyncWindows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	if m.OrphanedResources != nil {
+		l = m.OrphanedResources.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4353,6 +5445,12 @@ func (m *Application) Size() (n int) {
 		l = m.Operation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.PendingOperations) > 0 {
+		for _, e := range m.PendingOperations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4528,6 +5626,9 @@ func (m *ApplicationSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.RevisionHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
+	}
 	return n
 }
 
@@ -4572,6 +5673,20 @@ func (m *ApplicationStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.DeletionHookTypes) > 0 {
+		for _, s := range m.DeletionHookTypes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.DeletionHooksState != nil {
+		l = m.DeletionHooksState.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LastScheduledSyncAt != nil {
+		l = m.LastScheduledSyncAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4584,6 +5699,12 @@ func (m *ApplicationTree) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.OrphanedNodes) > 0 {
+		for _, e := range m.OrphanedNodes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4597,6 +5718,17 @@ func (m *ApplicationWatchEvent) Size() (n int) {
 	return n
 }
 
+func (m *Backoff) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Factor))
+	l = len(m.MaxDuration)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Cluster) Size() (n int) {
 	var l int
 	_ = l
@@ -4608,6 +5740,31 @@ func (m *Cluster) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.ConnectionState.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Shard != nil {
+		n += 1 + sovGenerated(uint64(*m.Shard))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ClusterCacheInfo) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ResourcesCount))
+	n += 1 + sovGenerated(uint64(m.APIsCount))
+	if m.LastCacheSyncTime != nil {
+		l = m.LastCacheSyncTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.SyncError)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4629,6 +5786,17 @@ func (m *ClusterConfig) Size() (n int) {
 	return n
 }
 
+func (m *ClusterInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ServerVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.CacheInfo.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ApplicationsCount))
+	return n
+}
+
 func (m *ClusterList) Size() (n int) {
 	var l int
 	_ = l
@@ -4711,6 +5879,30 @@ func (m *ConnectionState) Size() (n int) {
 	return n
 }
 
+func (m *DeletionHooksState) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *HealthStatus) Size() (n int) {
 	var l int
 	_ = l
@@ -4815,6 +6007,17 @@ func (m *Operation) Size() (n int) {
 		l = m.Sync.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = m.InitiatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OperationInitiator) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -4837,43 +6040,83 @@ func (m *OperationState) Size() (n int) {
 		l = m.FinishedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.RetryCount))
 	return n
 }
 
-func (m *ProjectRole) Size() (n int) {
+func (m *OrphanedResourceKey) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Group)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Description)
+	l = len(m.Kind)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Policies) > 0 {
-		for _, s := range m.Policies {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
+	var l int
+	_ = l
+	if m.Warn != nil {
+		n += 2
 	}
-	if len(m.JWTTokens) > 0 {
-		for _, e := range m.JWTTokens {
+	if len(m.Ignore) > 0 {
+		for _, e := range m.Ignore {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Groups) > 0 {
-		for _, s := range m.Groups {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *Repository) Size() (n int) {
+func (m *PendingOperation) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Repo)
+	l = len(m.ID)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Username)
+	l = m.Operation.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.QueuedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ProjectRole) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Description)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Policies) > 0 {
+		for _, s := range m.Policies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.JWTTokens) > 0 {
+		for _, e := range m.JWTTokens {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Repository) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Repo)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Username)
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Password)
 	n += 1 + l + sovGenerated(uint64(l))
@@ -5052,6 +6295,10 @@ func (m *ResourceResult) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.HookPhase)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.UID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GenerateName)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -5076,6 +6323,17 @@ func (m *ResourceStatus) Size() (n int) {
 	return n
 }
 
+func (m *RetryStrategy) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Limit))
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *RevisionHistory) Size() (n int) {
 	var l int
 	_ = l
@@ -5086,6 +6344,26 @@ func (m *RevisionHistory) Size() (n int) {
 	n += 1 + sovGenerated(uint64(m.ID))
 	l = m.Source.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.DeployStartedAt != nil {
+		l = m.DeployStartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.InitiatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncHealthCheck) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -5110,6 +6388,16 @@ func (m *SyncOperation) Size() (n int) {
 		l = m.Source.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HealthCheck != nil {
+		l = m.HealthCheck.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -5138,6 +6426,10 @@ func (m *SyncOperationResult) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Source.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.HealthCheckStartedAt != nil {
+		l = m.HealthCheckStartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -5148,6 +6440,20 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.Automated.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HealthCheck != nil {
+		l = m.HealthCheck.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.FieldManager)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -5155,6 +6461,21 @@ func (m *SyncPolicyAutomated) Size() (n int) {
 	var l int
 	_ = l
 	n += 2
+	n += 2
+	n += 1 + sovGenerated(uint64(m.PruneLimit))
+	n += 1 + sovGenerated(uint64(m.PrunePercentLimit))
+	return n
+}
+
+func (m *SyncSchedule) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Cron)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -5188,6 +6509,9 @@ func (m *SyncStrategyApply) Size() (n int) {
 	var l int
 	_ = l
 	n += 2
+	n += 2
+	l = len(m.FieldManager)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -5199,6 +6523,39 @@ func (m *SyncStrategyHook) Size() (n int) {
 	return n
 }
 
+func (m *SyncWindow) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *TLSClientConfig) Size() (n int) {
 	var l int
 	_ = l
@@ -5277,6 +6634,9 @@ func (this *AppProjectSpec) String() string {
 		`Roles:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Roles), "ProjectRole", "ProjectRole", 1), `&`, ``, 1) + `,`,
 		`ClusterResourceWhitelist:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ClusterResourceWhitelist), "GroupKind", "v1.GroupKind", 1), `&`, ``, 1) + `,`,
 		`NamespaceResourceBlacklist:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NamespaceResourceBlacklist), "GroupKind", "v1.GroupKind", 1), `&`, ``, 1) + `,`,
+		`SyncWindows:` + strings.Replace(fmt.Sprintf("%v", this.SyncWindows), "SyncWindow", "SyncWindow", 1) + `,`,
+		`AutoSyncDisabled:` + fmt.Sprintf("%v", this.AutoSyncDisabled) + `,`,
+		`OrphanedResources:` + strings.Replace(fmt.Sprintf("%v", this.OrphanedResources), "OrphanedResourcesMonitorSettings", "OrphanedResourcesMonitorSettings", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5290,6 +6650,7 @@ func (this *Application) String() string {
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ApplicationSpec", "ApplicationSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ApplicationStatus", "ApplicationStatus", 1), `&`, ``, 1) + `,`,
 		`Operation:` + strings.Replace(fmt.Sprintf("%v", this.Operation), "Operation", "Operation", 1) + `,`,
+		`PendingOperations:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.PendingOperations), "PendingOperation", "PendingOperation", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5420,6 +6781,7 @@ func (this *ApplicationSpec) String() string {
 		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
 		`SyncPolicy:` + strings.Replace(fmt.Sprintf("%v", this.SyncPolicy), "SyncPolicy", "SyncPolicy", 1) + `,`,
 		`IgnoreDifferences:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.IgnoreDifferences), "ResourceIgnoreDifferences", "ResourceIgnoreDifferences", 1), `&`, ``, 1) + `,`,
+		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5439,6 +6801,9 @@ func (this *ApplicationStatus) String() string {
 		`ObservedAt:` + strings.Replace(strings.Replace(this.ObservedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`SourceType:` + fmt.Sprintf("%v", this.SourceType) + `,`,
 		`Ingress:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Ingress), "LoadBalancerIngress", "v11.LoadBalancerIngress", 1), `&`, ``, 1) + `,`,
+		`DeletionHookTypes:` + fmt.Sprintf("%v", this.DeletionHookTypes) + `,`,
+		`DeletionHooksState:` + strings.Replace(fmt.Sprintf("%v", this.DeletionHooksState), "DeletionHooksState", "DeletionHooksState", 1) + `,`,
+		`LastScheduledSyncAt:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledSyncAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5449,6 +6814,7 @@ func (this *ApplicationTree) String() string {
 	}
 	s := strings.Join([]string{`&ApplicationTree{`,
 		`Nodes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Nodes), "ResourceNode", "ResourceNode", 1), `&`, ``, 1) + `,`,
+		`OrphanedNodes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OrphanedNodes), "ResourceNode", "ResourceNode", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5464,6 +6830,18 @@ func (this *ApplicationWatchEvent) String() string {
 	}, "")
 	return s
 }
+func (this *Backoff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Backoff{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Factor:` + fmt.Sprintf("%v", this.Factor) + `,`,
+		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Cluster) String() string {
 	if this == nil {
 		return "nil"
@@ -5473,6 +6851,22 @@ func (this *Cluster) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "ClusterConfig", "ClusterConfig", 1), `&`, ``, 1) + `,`,
 		`ConnectionState:` + strings.Replace(strings.Replace(this.ConnectionState.String(), "ConnectionState", "ConnectionState", 1), `&`, ``, 1) + `,`,
+		`Shard:` + valueToStringGenerated(this.Shard) + `,`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
+		`Info:` + strings.Replace(strings.Replace(this.Info.String(), "ClusterInfo", "ClusterInfo", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterCacheInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterCacheInfo{`,
+		`ResourcesCount:` + fmt.Sprintf("%v", this.ResourcesCount) + `,`,
+		`APIsCount:` + fmt.Sprintf("%v", this.APIsCount) + `,`,
+		`LastCacheSyncTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCacheSyncTime), "Time", "v1.Time", 1) + `,`,
+		`SyncError:` + fmt.Sprintf("%v", this.SyncError) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5491,6 +6885,18 @@ func (this *ClusterConfig) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterInfo{`,
+		`ServerVersion:` + fmt.Sprintf("%v", this.ServerVersion) + `,`,
+		`CacheInfo:` + strings.Replace(strings.Replace(this.CacheInfo.String(), "ClusterCacheInfo", "ClusterCacheInfo", 1), `&`, ``, 1) + `,`,
+		`ApplicationsCount:` + fmt.Sprintf("%v", this.ApplicationsCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ClusterList) String() string {
 	if this == nil {
 		return "nil"
@@ -5560,6 +6966,21 @@ func (this *ConnectionState) String() string {
 	}, "")
 	return s
 }
+func (this *DeletionHooksState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeletionHooksState{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceResult", "ResourceResult", 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HealthStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -5661,6 +7082,18 @@ func (this *Operation) String() string {
 	}
 	s := strings.Join([]string{`&Operation{`,
 		`Sync:` + strings.Replace(fmt.Sprintf("%v", this.Sync), "SyncOperation", "SyncOperation", 1) + `,`,
+		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OperationInitiator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperationInitiator{`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Automated:` + fmt.Sprintf("%v", this.Automated) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5676,6 +7109,42 @@ func (this *OperationState) String() string {
 		`SyncResult:` + strings.Replace(fmt.Sprintf("%v", this.SyncResult), "SyncOperationResult", "SyncOperationResult", 1) + `,`,
 		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`RetryCount:` + fmt.Sprintf("%v", this.RetryCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OrphanedResourceKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OrphanedResourceKey{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OrphanedResourcesMonitorSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OrphanedResourcesMonitorSettings{`,
+		`Warn:` + valueToStringGenerated(this.Warn) + `,`,
+		`Ignore:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Ignore), "OrphanedResourceKey", "OrphanedResourceKey", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingOperation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingOperation{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Operation:` + strings.Replace(strings.Replace(this.Operation.String(), "Operation", "Operation", 1), `&`, ``, 1) + `,`,
+		`QueuedAt:` + strings.Replace(strings.Replace(this.QueuedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5837,6 +7306,8 @@ func (this *ResourceResult) String() string {
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`HookType:` + fmt.Sprintf("%v", this.HookType) + `,`,
 		`HookPhase:` + fmt.Sprintf("%v", this.HookPhase) + `,`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`GenerateName:` + fmt.Sprintf("%v", this.GenerateName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5858,6 +7329,17 @@ func (this *ResourceStatus) String() string {
 	}, "")
 	return s
 }
+func (this *RetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryStrategy{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Backoff:` + strings.Replace(fmt.Sprintf("%v", this.Backoff), "Backoff", "Backoff", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RevisionHistory) String() string {
 	if this == nil {
 		return "nil"
@@ -5867,6 +7349,19 @@ func (this *RevisionHistory) String() string {
 		`DeployedAt:` + strings.Replace(strings.Replace(this.DeployedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`DeployStartedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeployStartedAt), "Time", "v1.Time", 1) + `,`,
+		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceResult", "ResourceResult", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncHealthCheck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncHealthCheck{`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5882,6 +7377,9 @@ func (this *SyncOperation) String() string {
 		`SyncStrategy:` + strings.Replace(fmt.Sprintf("%v", this.SyncStrategy), "SyncStrategy", "SyncStrategy", 1) + `,`,
 		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "SyncOperationResource", "SyncOperationResource", 1), `&`, ``, 1) + `,`,
 		`Source:` + strings.Replace(fmt.Sprintf("%v", this.Source), "ApplicationSource", "ApplicationSource", 1) + `,`,
+		`Retry:` + strings.Replace(fmt.Sprintf("%v", this.Retry), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`HealthCheck:` + strings.Replace(fmt.Sprintf("%v", this.HealthCheck), "SyncHealthCheck", "SyncHealthCheck", 1) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5906,6 +7404,7 @@ func (this *SyncOperationResult) String() string {
 		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceResult", "ResourceResult", 1) + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`HealthCheckStartedAt:` + strings.Replace(fmt.Sprintf("%v", this.HealthCheckStartedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5916,6 +7415,10 @@ func (this *SyncPolicy) String() string {
 	}
 	s := strings.Join([]string{`&SyncPolicy{`,
 		`Automated:` + strings.Replace(fmt.Sprintf("%v", this.Automated), "SyncPolicyAutomated", "SyncPolicyAutomated", 1) + `,`,
+		`Retry:` + strings.Replace(fmt.Sprintf("%v", this.Retry), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`HealthCheck:` + strings.Replace(fmt.Sprintf("%v", this.HealthCheck), "SyncHealthCheck", "SyncHealthCheck", 1) + `,`,
+		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "SyncSchedule", "SyncSchedule", 1) + `,`,
+		`FieldManager:` + fmt.Sprintf("%v", this.FieldManager) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5926,6 +7429,21 @@ func (this *SyncPolicyAutomated) String() string {
 	}
 	s := strings.Join([]string{`&SyncPolicyAutomated{`,
 		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
+		`SelfHeal:` + fmt.Sprintf("%v", this.SelfHeal) + `,`,
+		`PruneLimit:` + fmt.Sprintf("%v", this.PruneLimit) + `,`,
+		`PrunePercentLimit:` + fmt.Sprintf("%v", this.PrunePercentLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncSchedule{`,
+		`Cron:` + fmt.Sprintf("%v", this.Cron) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5959,6 +7477,8 @@ func (this *SyncStrategyApply) String() string {
 	}
 	s := strings.Join([]string{`&SyncStrategyApply{`,
 		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
+		`ServerSideApply:` + fmt.Sprintf("%v", this.ServerSideApply) + `,`,
+		`FieldManager:` + fmt.Sprintf("%v", this.FieldManager) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5973,6 +7493,23 @@ func (this *SyncStrategyHook) String() string {
 	}, "")
 	return s
 }
+func (this *SyncWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindow{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Applications:` + fmt.Sprintf("%v", this.Applications) + `,`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
+		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
+		`ManualSync:` + fmt.Sprintf("%v", this.ManualSync) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TLSClientConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -6535,36 +8072,120 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWindows", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Application) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.SyncWindows = append(m.SyncWindows, &SyncWindow{})
+			if err := m.SyncWindows[len(m.SyncWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSyncDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoSyncDisabled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrphanedResources == nil {
+				m.OrphanedResources = &OrphanedResourcesMonitorSettings{}
+			}
+			if err := m.OrphanedResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Application) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -6708,6 +8329,37 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOperations = append(m.PendingOperations, PendingOperation{})
+			if err := m.PendingOperations[len(m.PendingOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8190,6 +9842,26 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevisionHistoryLimit = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8546,6 +10218,101 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionHookTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletionHookTypes = append(m.DeletionHookTypes, HookType(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionHooksState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletionHooksState == nil {
+				m.DeletionHooksState = &DeletionHooksState{}
+			}
+			if err := m.DeletionHooksState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledSyncAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastScheduledSyncAt == nil {
+				m.LastScheduledSyncAt = &v1.Time{}
+			}
+			if err := m.LastScheduledSyncAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8627,6 +10394,37 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrphanedNodes = append(m.OrphanedNodes, ResourceNode{})
+			if err := m.OrphanedNodes[len(m.OrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8757,7 +10555,7 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *Backoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8780,15 +10578,15 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8813,11 +10611,30 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			m.Factor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Factor |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8842,67 +10659,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8925,7 +10682,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
+func (m *Cluster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8948,15 +10705,15 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8981,11 +10738,11 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9010,13 +10767,13 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9026,24 +10783,25 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9067,13 +10825,62 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Shard = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9097,10 +10904,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AWSAuthConfig == nil {
-				m.AWSAuthConfig = &AWSAuthConfig{}
-			}
-			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9125,7 +10929,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterList) Unmarshal(dAtA []byte) error {
+func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9148,15 +10952,53 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCacheInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
+			}
+			m.ResourcesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourcesCount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIsCount", wireType)
+			}
+			m.APIsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.APIsCount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCacheSyncTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9180,15 +11022,18 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastCacheSyncTime == nil {
+				m.LastCacheSyncTime = &v1.Time{}
+			}
+			if err := m.LastCacheSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9198,22 +11043,20 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Cluster{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SyncError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9236,7 +11079,7 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Command) Unmarshal(dAtA []byte) error {
+func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9259,15 +11102,15 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9292,11 +11135,11 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9321,61 +11164,40 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ComparedTo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9399,13 +11221,13 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9429,7 +11251,10 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AWSAuthConfig == nil {
+				m.AWSAuthConfig = &AWSAuthConfig{}
+			}
+			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9454,7 +11279,7 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
+func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9477,15 +11302,15 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9510,13 +11335,13 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Component = string(dAtA[iNdEx:postIndex])
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9526,26 +11351,27 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.CacheInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsCount", wireType)
 			}
-			var stringLen uint64
+			m.ApplicationsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9555,21 +11381,11 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ApplicationsCount |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9591,7 +11407,7 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
+func (m *ClusterList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9614,44 +11430,15 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9675,16 +11462,13 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Init == nil {
-				m.Init = &Command{}
-			}
-			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9708,7 +11492,8 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Cluster{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9733,7 +11518,7 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *Command) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9756,15 +11541,15 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9789,11 +11574,11 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9818,40 +11603,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ModifiedAt == nil {
-				m.ModifiedAt = &v1.Time{}
-			}
-			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9874,7 +11626,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
+func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9897,17 +11649,17 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9917,26 +11669,27 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9946,20 +11699,21 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9982,7 +11736,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmParameter) Unmarshal(dAtA []byte) error {
+func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10005,13 +11759,42 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Component = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -10040,7 +11823,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
@@ -10090,7 +11873,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmRepository) Unmarshal(dAtA []byte) error {
+func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10113,15 +11896,15 @@ func (m *HelmRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10146,13 +11929,13 @@ func (m *HelmRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10162,26 +11945,30 @@ func (m *HelmRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Init == nil {
+				m.Init = &Command{}
+			}
+			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CAData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10191,59 +11978,77 @@ func (m *HelmRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CAData = append(m.CAData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CAData == nil {
-				m.CAData = []byte{}
+			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CertData == nil {
-				m.CertData = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10253,26 +12058,24 @@ func (m *HelmRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyData = append(m.KeyData[:0], dAtA[iNdEx:postIndex]...)
-			if m.KeyData == nil {
-				m.KeyData = []byte{}
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10297,13 +12100,13 @@ func (m *HelmRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10313,20 +12116,24 @@ func (m *HelmRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			if m.ModifiedAt == nil {
+				m.ModifiedAt = &v1.Time{}
+			}
+			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10349,7 +12156,7 @@ func (m *HelmRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *DeletionHooksState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10372,15 +12179,15 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeletionHooksState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeletionHooksState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10405,11 +12212,11 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10434,63 +12241,42 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			m.IssuedAt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10500,16 +12286,28 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ExpiresAt = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10519,14 +12317,58 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -10545,7 +12387,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10568,15 +12410,15 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10601,11 +12443,11 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10630,28 +12472,8 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10673,7 +12495,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
+func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10696,42 +12518,13 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KsonnetParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KsonnetParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Component = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -10760,7 +12553,7 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
@@ -10810,7 +12603,7 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeImageTag) Unmarshal(dAtA []byte) error {
+func (m *HelmRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10833,15 +12626,15 @@ func (m *KustomizeImageTag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeImageTag: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeImageTag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10866,11 +12659,11 @@ func (m *KustomizeImageTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10895,63 +12688,44 @@ func (m *KustomizeImageTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CAData", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Operation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CAData = append(m.CAData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CAData == nil {
+				m.CAData = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10961,80 +12735,59 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sync == nil {
-				m.Sync = &SyncOperation{}
-			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CertData == nil {
+				m.CertData = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
 			}
-			if skippy < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperationState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.KeyData = append(m.KeyData[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyData == nil {
+				m.KeyData = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11044,25 +12797,24 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11087,11 +12839,61 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11116,13 +12918,13 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11132,30 +12934,76 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncResult == nil {
-				m.SyncResult = &SyncOperationResult{}
-			}
-			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11165,27 +13013,16 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.IssuedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var msglen int
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11195,25 +13032,11 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ExpiresAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11235,7 +13058,7 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11258,10 +13081,10 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11295,7 +13118,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11320,13 +13143,13 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11336,88 +13159,19 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JWTTokens = append(m.JWTTokens, JWTToken{})
-			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			m.Code = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) > l {
@@ -11432,7 +13186,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Repository) Unmarshal(dAtA []byte) error {
+func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11455,15 +13209,15 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
+			return fmt.Errorf("proto: KsonnetParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KsonnetParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11488,11 +13242,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Component = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11517,11 +13271,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11546,11 +13300,61 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeImageTag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeImageTag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeImageTag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11575,13 +13379,13 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11591,42 +13395,21 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11648,7 +13431,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+func (m *Operation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11671,15 +13454,15 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11703,13 +13486,16 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Sync == nil {
+				m.Sync = &SyncOperation{}
+			}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11733,8 +13519,7 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Repository{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11759,7 +13544,7 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
+func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11782,15 +13567,15 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11815,13 +13600,13 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11831,53 +13616,95 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Automated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11902,11 +13729,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11931,13 +13758,46 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetState = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
+			}
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11947,26 +13807,27 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiveState = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11976,21 +13837,44 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Diff = string(dAtA[iNdEx:postIndex])
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12012,7 +13896,7 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12035,10 +13919,10 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12128,11 +14012,61 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12142,26 +14076,18 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := bool(v != 0)
+			m.Warn = &b
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12171,20 +14097,22 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
+			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12207,7 +14135,7 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
+func (m *PendingOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12230,17 +14158,17 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12250,113 +14178,24 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetLabels == nil {
-				m.TargetLabels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.TargetLabels[mapkey] = mapvalue
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12380,14 +14219,13 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
-			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12411,103 +14249,65 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.QueuedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12517,22 +14317,2166 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JWTTokens = append(m.JWTTokens, JWTToken{})
+			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Repository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Repository{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetLabels == nil {
+				m.TargetLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TargetLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
+			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ingress = append(m.Ingress, v11.LoadBalancerIngress{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
+			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, InfoItem{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NetworkingInfo == nil {
+				m.NetworkingInfo = &ResourceNetworkingInfo{}
+			}
+			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthLua = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoreDifferences = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ResultCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookType = HookType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookPhase = OperationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = append(m.Ingress, v11.LoadBalancerIngress{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GenerateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12555,7 +16499,7 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12578,17 +16522,17 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12598,27 +16542,26 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12628,28 +16571,26 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
-			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12659,28 +16600,26 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, InfoItem{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12690,28 +16629,24 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkingInfo == nil {
-				m.NetworkingInfo = &ResourceNetworkingInfo{}
-			}
-			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12736,11 +16671,11 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12765,8 +16700,58 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hook = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12788,7 +16773,7 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12811,17 +16796,17 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12831,26 +16816,16 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Limit |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HealthLua = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12860,20 +16835,24 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreDifferences = string(dAtA[iNdEx:postIndex])
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12896,7 +16875,7 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceRef) Unmarshal(dAtA []byte) error {
+func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12919,17 +16898,95 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12939,26 +16996,27 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployStartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12968,26 +17026,30 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.DeployStartedAt == nil {
+				m.DeployStartedAt = &v1.Time{}
+			}
+			if err := m.DeployStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12997,26 +17059,27 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13026,24 +17089,76 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncHealthCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncHealthCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncHealthCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13068,7 +17183,7 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13091,7 +17206,7 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceResult) Unmarshal(dAtA []byte) error {
+func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13114,15 +17229,15 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13147,13 +17262,13 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13163,26 +17278,17 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Prune = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13192,26 +17298,17 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13221,26 +17318,30 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.SyncStrategy == nil {
+				m.SyncStrategy = &SyncStrategy{}
+			}
+			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13250,26 +17351,28 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Resources = append(m.Resources, SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13279,26 +17382,30 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ResultCode(dAtA[iNdEx:postIndex])
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13308,26 +17415,30 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheck", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13337,24 +17448,28 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookType = HookType(dAtA[iNdEx:postIndex])
+			if m.HealthCheck == nil {
+				m.HealthCheck = &SyncHealthCheck{}
+			}
+			if err := m.HealthCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13379,7 +17494,7 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookPhase = OperationPhase(dAtA[iNdEx:postIndex])
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13402,7 +17517,7 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13425,10 +17540,10 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13462,7 +17577,7 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13487,11 +17602,11 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13516,13 +17631,63 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13532,24 +17697,26 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13574,13 +17741,13 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13590,24 +17757,25 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheckStartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13631,30 +17799,13 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.HealthCheckStartedAt == nil {
+				m.HealthCheckStartedAt = &v1.Time{}
+			}
+			if err := m.HealthCheckStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Hook = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13676,7 +17827,7 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13699,17 +17850,17 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13719,24 +17870,28 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if m.Automated == nil {
+				m.Automated = &SyncPolicyAutomated{}
+			}
+			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13760,15 +17915,18 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheck", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13778,14 +17936,28 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HealthCheck == nil {
+				m.HealthCheck = &SyncHealthCheck{}
+			}
+			if err := m.HealthCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13809,10 +17981,42 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Schedule == nil {
+				m.Schedule = &SyncSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13834,7 +18038,7 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperation) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13857,17 +18061,17 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13877,24 +18081,15 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Prune = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -13911,12 +18106,31 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
+			m.SelfHeal = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneLimit", wireType)
+			}
+			m.PruneLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneLimit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunePercentLimit", wireType)
 			}
-			var v int
+			m.PrunePercentLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13926,17 +18140,66 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.PrunePercentLimit |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13946,30 +18209,26 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncStrategy == nil {
-				m.SyncStrategy = &SyncStrategy{}
-			}
-			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13979,28 +18238,26 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, SyncOperationResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14010,24 +18267,20 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14050,7 +18303,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14073,15 +18326,15 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14106,13 +18359,13 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComparedTo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14122,24 +18375,25 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.ComparedTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14164,7 +18418,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14187,7 +18441,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14210,15 +18464,15 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14242,43 +18496,16 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &ResourceResult{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Apply == nil {
+				m.Apply = &SyncStrategyApply{}
+			}
+			if err := m.Apply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14302,7 +18529,10 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Hook == nil {
+				m.Hook = &SyncStrategyHook{}
+			}
+			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14327,7 +18557,7 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14350,17 +18580,57 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSideApply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ServerSideApply = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldManager", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14370,24 +18640,20 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Automated == nil {
-				m.Automated = &SyncPolicyAutomated{}
-			}
-			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FieldManager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14410,7 +18676,7 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
+func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14433,17 +18699,17 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14453,12 +18719,22 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14480,7 +18756,7 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14503,15 +18779,15 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14536,13 +18812,13 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComparedTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14552,25 +18828,24 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ComparedTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14595,63 +18870,13 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14661,30 +18886,26 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Apply == nil {
-				m.Apply = &SyncStrategyApply{}
-			}
-			if err := m.Apply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14694,78 +18915,53 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hook == nil {
-				m.Hook = &SyncStrategyHook{}
-			}
-			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -14782,62 +18978,12 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ManualSync = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14847,21 +18993,20 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15186,239 +19331,312 @@ func init() {
 }
 
 var fileDescriptor_generated_090fe54925d89cd3 = []byte{
-	// 3744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3b, 0xcd, 0x8f, 0x1c, 0xc7,
-	0x57, 0xee, 0xf9, 0x9e, 0xb7, 0x1f, 0xf6, 0xd6, 0x2f, 0xf6, 0x6f, 0x7e, 0xab, 0x64, 0x77, 0xd5,
-	0x16, 0x89, 0x03, 0x64, 0x16, 0x5b, 0x09, 0x38, 0x89, 0x04, 0xda, 0xd9, 0xf5, 0xc7, 0x7a, 0xd7,
-	0xeb, 0x4d, 0xcd, 0xda, 0x96, 0x42, 0x08, 0x69, 0xf7, 0xd4, 0xcc, 0xb4, 0x77, 0xa6, 0xbb, 0xd3,
-	0xdd, 0xb3, 0xf6, 0x18, 0xf2, 0x05, 0x8a, 0x04, 0x21, 0x89, 0x90, 0x10, 0x27, 0x94, 0x4b, 0x8e,
-	0x11, 0x17, 0x40, 0xe2, 0x0f, 0x40, 0x02, 0x72, 0x8c, 0xa2, 0x44, 0x8a, 0x00, 0xad, 0xc8, 0xe6,
-	0x00, 0x12, 0x07, 0x0e, 0x88, 0x8b, 0x2f, 0xa0, 0xfa, 0xea, 0xaa, 0x9e, 0x9d, 0xf1, 0x8e, 0x3d,
-	0x6d, 0x47, 0x84, 0xdb, 0xf4, 0x7b, 0x55, 0xef, 0xbd, 0x7a, 0xf5, 0xea, 0x7d, 0x55, 0x0d, 0xac,
-	0xb7, 0x9c, 0xa8, 0xdd, 0xbb, 0x55, 0xb5, 0xbd, 0xee, 0xb2, 0x15, 0xb4, 0x3c, 0x3f, 0xf0, 0x6e,
-	0xb3, 0x1f, 0x2f, 0xd8, 0x8d, 0x65, 0x7f, 0xb7, 0xb5, 0x6c, 0xf9, 0x4e, 0xb8, 0x6c, 0xf9, 0x7e,
-	0xc7, 0xb1, 0xad, 0xc8, 0xf1, 0xdc, 0xe5, 0xbd, 0xb3, 0x56, 0xc7, 0x6f, 0x5b, 0x67, 0x97, 0x5b,
-	0xc4, 0x25, 0x81, 0x15, 0x91, 0x46, 0xd5, 0x0f, 0xbc, 0xc8, 0x43, 0x2f, 0x2b, 0x52, 0x55, 0x49,
-	0x8a, 0xfd, 0xf8, 0x5d, 0xbb, 0x51, 0xf5, 0x77, 0x5b, 0x55, 0x4a, 0xaa, 0xaa, 0x91, 0xaa, 0x4a,
-	0x52, 0xf3, 0x2f, 0x68, 0x52, 0xb4, 0xbc, 0x96, 0xb7, 0xcc, 0x28, 0xde, 0xea, 0x35, 0xd9, 0x17,
-	0xfb, 0x60, 0xbf, 0x38, 0xa7, 0x79, 0x73, 0xf7, 0x7c, 0x58, 0x75, 0x3c, 0x2a, 0xdb, 0xb2, 0xed,
-	0x05, 0x64, 0x79, 0xef, 0x90, 0x34, 0xf3, 0x2f, 0xaa, 0x31, 0x5d, 0xcb, 0x6e, 0x3b, 0x2e, 0x09,
-	0xfa, 0x6a, 0x41, 0x5d, 0x12, 0x59, 0xc3, 0x66, 0x2d, 0x8f, 0x9a, 0x15, 0xf4, 0xdc, 0xc8, 0xe9,
-	0x92, 0x43, 0x13, 0x7e, 0xfd, 0xa8, 0x09, 0xa1, 0xdd, 0x26, 0x5d, 0x6b, 0x70, 0x9e, 0xf9, 0x36,
-	0xcc, 0xac, 0xdc, 0xac, 0xaf, 0xf4, 0xa2, 0xf6, 0xaa, 0xe7, 0x36, 0x9d, 0x16, 0x7a, 0x09, 0xa6,
-	0xec, 0x4e, 0x2f, 0x8c, 0x48, 0xb0, 0x65, 0x75, 0x49, 0xc5, 0x58, 0x32, 0xce, 0x94, 0x6b, 0x3f,
-	0xfb, 0x72, 0x7f, 0xf1, 0xd8, 0xc1, 0xfe, 0xe2, 0xd4, 0xaa, 0x42, 0x61, 0x7d, 0x1c, 0x7a, 0x1e,
-	0x8a, 0x81, 0xd7, 0x21, 0x2b, 0x78, 0xab, 0x92, 0x61, 0x53, 0x8e, 0x8b, 0x29, 0x45, 0xcc, 0xc1,
-	0x58, 0xe2, 0xcd, 0x7f, 0x36, 0x00, 0x56, 0x7c, 0x7f, 0x3b, 0xf0, 0x6e, 0x13, 0x3b, 0x42, 0x6f,
-	0x41, 0x89, 0x6a, 0xa1, 0x61, 0x45, 0x16, 0xe3, 0x36, 0x75, 0xee, 0xd7, 0xaa, 0x7c, 0x31, 0x55,
-	0x7d, 0x31, 0x6a, 0xe7, 0xe8, 0xe8, 0xea, 0xde, 0xd9, 0xea, 0xb5, 0x5b, 0x74, 0xfe, 0x55, 0x12,
-	0x59, 0x35, 0x24, 0x98, 0x81, 0x82, 0xe1, 0x98, 0x2a, 0xda, 0x85, 0x5c, 0xe8, 0x13, 0x9b, 0x09,
-	0x36, 0x75, 0x6e, 0xbd, 0xfa, 0xc8, 0xf6, 0x51, 0x55, 0x62, 0xd7, 0x7d, 0x62, 0xd7, 0xa6, 0x05,
-	0xdb, 0x1c, 0xfd, 0xc2, 0x8c, 0x89, 0xf9, 0x4f, 0x06, 0xcc, 0xaa, 0x61, 0x9b, 0x4e, 0x18, 0xa1,
-	0x37, 0x0e, 0xad, 0xb0, 0x3a, 0xde, 0x0a, 0xe9, 0x6c, 0xb6, 0xbe, 0x13, 0x82, 0x51, 0x49, 0x42,
-	0xb4, 0xd5, 0xdd, 0x86, 0xbc, 0x13, 0x91, 0x6e, 0x58, 0xc9, 0x2c, 0x65, 0xcf, 0x4c, 0x9d, 0xbb,
-	0x90, 0xca, 0xf2, 0x6a, 0x33, 0x82, 0x63, 0x7e, 0x9d, 0xd2, 0xc6, 0x9c, 0x85, 0xf9, 0x17, 0x79,
-	0x7d, 0x71, 0x74, 0xd5, 0xe8, 0x2c, 0x4c, 0x85, 0x5e, 0x2f, 0xb0, 0x09, 0x26, 0xbe, 0x17, 0x56,
-	0x8c, 0xa5, 0x2c, 0xdd, 0x7c, 0x6a, 0x2b, 0x75, 0x05, 0xc6, 0xfa, 0x18, 0xf4, 0x27, 0x06, 0x4c,
-	0x37, 0x48, 0x18, 0x39, 0x2e, 0xe3, 0x2f, 0x25, 0x7f, 0x6d, 0x32, 0xc9, 0x25, 0x70, 0x4d, 0x51,
-	0xae, 0x3d, 0x25, 0x56, 0x31, 0xad, 0x01, 0x43, 0x9c, 0x60, 0x4e, 0x0d, 0xbe, 0x41, 0x42, 0x3b,
-	0x70, 0x7c, 0xfa, 0x5d, 0xc9, 0x26, 0x0d, 0x7e, 0x4d, 0xa1, 0xb0, 0x3e, 0x0e, 0xed, 0x42, 0x9e,
-	0x1a, 0x74, 0x58, 0xc9, 0x31, 0xe1, 0x2f, 0x4e, 0x20, 0xbc, 0x50, 0x27, 0x3d, 0x28, 0x4a, 0xef,
-	0xf4, 0x2b, 0xc4, 0x9c, 0x07, 0xfa, 0xc4, 0x80, 0x8a, 0x38, 0x6d, 0x98, 0x70, 0x55, 0xde, 0x6c,
-	0x3b, 0x11, 0xe9, 0x38, 0x61, 0x54, 0xc9, 0x33, 0x01, 0x96, 0xc7, 0x33, 0xa9, 0x4b, 0x81, 0xd7,
-	0xf3, 0x37, 0x1c, 0xb7, 0x51, 0x5b, 0x12, 0x9c, 0x2a, 0xab, 0x23, 0x08, 0xe3, 0x91, 0x2c, 0xd1,
-	0x9f, 0x19, 0x30, 0xef, 0x5a, 0x5d, 0x12, 0xfa, 0x16, 0xdd, 0x54, 0x8e, 0xae, 0x75, 0x2c, 0x7b,
-	0x97, 0x49, 0x54, 0x78, 0x34, 0x89, 0x4c, 0x21, 0xd1, 0xfc, 0xd6, 0x48, 0xd2, 0xf8, 0x01, 0x6c,
-	0xcd, 0x7f, 0xc8, 0xc2, 0x94, 0x66, 0x08, 0x4f, 0xc0, 0xb3, 0x74, 0x12, 0x9e, 0xe5, 0x4a, 0x3a,
-	0x06, 0x3c, 0xca, 0xb5, 0xa0, 0x08, 0x0a, 0x61, 0x64, 0x45, 0xbd, 0x90, 0x19, 0xe9, 0xd4, 0xb9,
-	0xcd, 0x94, 0xf8, 0x31, 0x9a, 0xb5, 0x59, 0xc1, 0xb1, 0xc0, 0xbf, 0xb1, 0xe0, 0x85, 0xde, 0x86,
-	0xb2, 0xe7, 0xd3, 0x98, 0x41, 0x4f, 0x47, 0x8e, 0x31, 0x5e, 0x9b, 0x80, 0xf1, 0x35, 0x49, 0xab,
-	0x36, 0x73, 0xb0, 0xbf, 0x58, 0x8e, 0x3f, 0xb1, 0xe2, 0x62, 0xda, 0xf0, 0x94, 0x26, 0xdf, 0xaa,
-	0xe7, 0x36, 0x1c, 0xb6, 0xa1, 0x4b, 0x90, 0x8b, 0xfa, 0xbe, 0x0c, 0x4a, 0xb1, 0x8a, 0x76, 0xfa,
-	0x3e, 0xc1, 0x0c, 0x43, 0xc3, 0x50, 0x97, 0x84, 0xa1, 0xd5, 0x22, 0x83, 0x61, 0xe8, 0x2a, 0x07,
-	0x63, 0x89, 0x37, 0xdf, 0x86, 0x53, 0xc3, 0xbd, 0x06, 0x7a, 0x16, 0x0a, 0x21, 0x09, 0xf6, 0x48,
-	0x20, 0x18, 0x29, 0xcd, 0x30, 0x28, 0x16, 0x58, 0xb4, 0x0c, 0xe5, 0xd8, 0x1a, 0x05, 0xbb, 0x39,
-	0x31, 0xb4, 0xac, 0x4c, 0x58, 0x8d, 0x31, 0xff, 0xc5, 0x80, 0xe3, 0x1a, 0xcf, 0x27, 0x10, 0x1c,
-	0x76, 0x93, 0xc1, 0xe1, 0x62, 0x3a, 0x16, 0x33, 0x22, 0x3a, 0x7c, 0x5a, 0x80, 0x39, 0xdd, 0xae,
-	0xd8, 0xf1, 0x64, 0x99, 0x01, 0xf1, 0xbd, 0xeb, 0x78, 0x53, 0xa8, 0x53, 0x65, 0x06, 0x1c, 0x8c,
-	0x25, 0x9e, 0xee, 0xaf, 0x6f, 0x45, 0x6d, 0xa1, 0xcb, 0x78, 0x7f, 0xb7, 0xad, 0xa8, 0x8d, 0x19,
-	0x06, 0xfd, 0x26, 0xcc, 0x46, 0x56, 0xd0, 0x22, 0x11, 0x26, 0x7b, 0x4e, 0x28, 0x2d, 0xb2, 0x5c,
-	0x3b, 0x25, 0xc6, 0xce, 0xee, 0x24, 0xb0, 0x78, 0x60, 0x34, 0x72, 0x21, 0xd7, 0x26, 0x9d, 0x6e,
-	0xa5, 0xc8, 0x34, 0xbd, 0x9d, 0xd2, 0x01, 0x62, 0x0b, 0xbd, 0x4c, 0x3a, 0xdd, 0x5a, 0x89, 0xca,
-	0x4b, 0x7f, 0x61, 0xc6, 0x07, 0xfd, 0x81, 0x01, 0xe5, 0xdd, 0x5e, 0x18, 0x79, 0x5d, 0xe7, 0x1e,
-	0xa9, 0x94, 0x18, 0xd7, 0xeb, 0x69, 0x72, 0xdd, 0x90, 0xc4, 0xf9, 0x71, 0x8a, 0x3f, 0xb1, 0x62,
-	0x8b, 0xee, 0x41, 0x71, 0x37, 0xf4, 0x5c, 0x97, 0x44, 0x95, 0x32, 0x93, 0xa0, 0x9e, 0xaa, 0x04,
-	0x9c, 0x74, 0x6d, 0x8a, 0x6e, 0xa9, 0xf8, 0xc0, 0x92, 0x21, 0x53, 0x40, 0xc3, 0x09, 0x88, 0x1d,
-	0x79, 0x41, 0xbf, 0x02, 0xe9, 0x2b, 0x60, 0x4d, 0x12, 0xe7, 0x0a, 0x88, 0x3f, 0xb1, 0x62, 0x8b,
-	0xf6, 0xa0, 0xe0, 0x77, 0x7a, 0x2d, 0xc7, 0xad, 0x4c, 0x31, 0x01, 0x70, 0x9a, 0x02, 0x6c, 0x33,
-	0xca, 0x35, 0xa0, 0x0e, 0x82, 0xff, 0xc6, 0x82, 0x9b, 0xf9, 0x8f, 0x06, 0xcc, 0x8f, 0x16, 0x98,
-	0x9f, 0x0c, 0xbb, 0x17, 0x84, 0xdc, 0xa3, 0x95, 0xf4, 0x93, 0xc1, 0xc0, 0x58, 0xe2, 0xd1, 0xbb,
-	0x50, 0xbc, 0x2d, 0xb6, 0x30, 0x93, 0xfe, 0x16, 0x5e, 0x11, 0x5b, 0x18, 0xf3, 0xbf, 0x22, 0xb7,
-	0x51, 0x30, 0x35, 0xff, 0xde, 0x80, 0x93, 0x43, 0x2d, 0x1e, 0x55, 0x01, 0xf6, 0xac, 0x4e, 0x8f,
-	0x5c, 0x74, 0x68, 0x32, 0xc4, 0xd3, 0xbf, 0x59, 0x1a, 0x30, 0x6f, 0xc4, 0x50, 0xac, 0x8d, 0x40,
-	0xbf, 0x0f, 0xe0, 0x5b, 0x81, 0xd5, 0x25, 0x11, 0x09, 0xa4, 0x5b, 0xba, 0x3c, 0xc1, 0x62, 0xa8,
-	0x10, 0xdb, 0x92, 0xa0, 0x0a, 0xd7, 0x31, 0x28, 0xc4, 0x1a, 0x3f, 0xf3, 0xbf, 0x0d, 0xa8, 0x8c,
-	0x5a, 0x3e, 0xf2, 0xa1, 0x48, 0xee, 0x46, 0x37, 0xac, 0x80, 0xaf, 0x63, 0xb2, 0x5c, 0x5a, 0x10,
-	0xbd, 0x61, 0x05, 0x4a, 0xad, 0x17, 0x38, 0x75, 0x2c, 0xd9, 0xa0, 0x16, 0xe4, 0xa2, 0x8e, 0x95,
-	0x46, 0xea, 0xae, 0xb1, 0x53, 0x71, 0x71, 0x73, 0x25, 0xc4, 0x8c, 0x81, 0xf9, 0xf5, 0xb0, 0x75,
-	0x8b, 0xc3, 0x4a, 0x33, 0x60, 0xe2, 0xee, 0x39, 0x81, 0xe7, 0x76, 0x89, 0x1b, 0x0d, 0x96, 0x7c,
-	0x17, 0x14, 0x0a, 0xeb, 0xe3, 0xd0, 0x7b, 0x43, 0x76, 0x72, 0x63, 0x82, 0x25, 0x08, 0x71, 0xc6,
-	0xdf, 0xcc, 0xff, 0x1a, 0x76, 0xbc, 0x62, 0x0f, 0x88, 0xce, 0x01, 0xd0, 0xd0, 0xbb, 0x1d, 0x90,
-	0xa6, 0x73, 0x57, 0xac, 0x2a, 0x26, 0xb9, 0x15, 0x63, 0xb0, 0x36, 0x0a, 0xbd, 0x03, 0x65, 0xa7,
-	0x6b, 0xb5, 0xc8, 0x8e, 0xd5, 0x92, 0x4b, 0x9a, 0x24, 0xcb, 0x8a, 0x85, 0x59, 0x17, 0x44, 0x55,
-	0x82, 0x20, 0x21, 0x21, 0x56, 0x1c, 0x91, 0x09, 0x05, 0xf6, 0x41, 0x33, 0x3c, 0x7a, 0x90, 0x98,
-	0x53, 0x61, 0x23, 0x43, 0x2c, 0x30, 0xe6, 0xab, 0xf0, 0xf3, 0x11, 0x3e, 0x88, 0xc6, 0x4f, 0x57,
-	0x15, 0xed, 0xb1, 0x1d, 0xb0, 0x6a, 0x9d, 0x61, 0xcc, 0x6f, 0x72, 0x89, 0x0c, 0xa4, 0x2e, 0xd3,
-	0x4a, 0x46, 0x45, 0xe4, 0x1f, 0x9b, 0x69, 0xba, 0x16, 0x2d, 0x79, 0xe2, 0x15, 0xa0, 0xe0, 0x85,
-	0xfe, 0xc8, 0x60, 0x75, 0x97, 0x4c, 0xba, 0x84, 0x5b, 0x7b, 0x0c, 0x35, 0xa0, 0x5e, 0xca, 0x49,
-	0x20, 0xd6, 0x59, 0x53, 0x3f, 0xec, 0xf3, 0x12, 0x4c, 0x54, 0x7f, 0xf1, 0x81, 0x95, 0x95, 0x99,
-	0xc4, 0xa3, 0x1e, 0x40, 0xd8, 0x77, 0xed, 0x6d, 0xaf, 0xe3, 0xd8, 0x7d, 0x91, 0x0d, 0x4f, 0x72,
-	0x6c, 0xeb, 0x31, 0x31, 0xee, 0x34, 0xd5, 0x37, 0xd6, 0x18, 0xa1, 0xcf, 0x0c, 0x98, 0x73, 0x5a,
-	0xae, 0x17, 0x90, 0x35, 0xa7, 0xd9, 0x24, 0x01, 0x71, 0x6d, 0x12, 0x8a, 0xc2, 0x6f, 0x67, 0x02,
-	0xf6, 0xb2, 0x86, 0x5a, 0x1f, 0xa4, 0x5d, 0xfb, 0x85, 0x50, 0xc1, 0xdc, 0x21, 0x14, 0x3e, 0x2c,
-	0x89, 0xf9, 0x6d, 0x29, 0x99, 0xf9, 0xf1, 0xca, 0xe1, 0x1e, 0x94, 0x03, 0xc1, 0x40, 0x7a, 0xd4,
-	0xf5, 0x14, 0x84, 0x15, 0xf5, 0x4a, 0x7c, 0x92, 0x24, 0x3c, 0xc4, 0x8a, 0x1d, 0xf5, 0xac, 0x54,
-	0x7f, 0xc2, 0xac, 0x26, 0xdd, 0x22, 0xc1, 0x52, 0x15, 0x65, 0x7d, 0x97, 0x16, 0x65, 0x7d, 0xd7,
-	0x46, 0x1e, 0x14, 0xda, 0xc4, 0xea, 0x44, 0x6d, 0x51, 0x94, 0x5d, 0x9a, 0x28, 0x96, 0x51, 0x42,
-	0x83, 0xf5, 0x18, 0x87, 0x62, 0xc1, 0x06, 0xf5, 0xa0, 0xd8, 0x76, 0x42, 0x96, 0x4e, 0xf1, 0xd6,
-	0xc3, 0x95, 0x89, 0x74, 0xca, 0x13, 0xe3, 0xcb, 0x9c, 0xa2, 0xb2, 0x7c, 0x01, 0xc0, 0x92, 0x17,
-	0xfa, 0x43, 0x03, 0xc0, 0x96, 0x95, 0x98, 0xb4, 0xbd, 0x6b, 0xe9, 0x1c, 0xd7, 0xb8, 0xc2, 0x53,
-	0xfe, 0x39, 0x06, 0x85, 0x58, 0x63, 0x8b, 0x1a, 0x30, 0x1d, 0x10, 0xdb, 0x73, 0x6d, 0xa7, 0x43,
-	0x1a, 0x2b, 0x51, 0xa5, 0xc0, 0x74, 0xfe, 0xcb, 0xe3, 0x55, 0x4c, 0x3b, 0x4e, 0x97, 0xa8, 0x96,
-	0x10, 0xd6, 0xe8, 0xe0, 0x04, 0x55, 0xf4, 0xa1, 0x01, 0xb3, 0x71, 0x35, 0x4a, 0xb7, 0x83, 0x88,
-	0x82, 0x61, 0x3d, 0x8d, 0xc2, 0x97, 0x11, 0xac, 0x21, 0x5a, 0xad, 0x24, 0x61, 0x78, 0x80, 0x29,
-	0x7a, 0x13, 0xc0, 0xbb, 0xc5, 0x8a, 0x4d, 0xba, 0xd6, 0xd2, 0x43, 0xaf, 0x55, 0x6b, 0x5e, 0x48,
-	0x2a, 0x58, 0xa3, 0x88, 0x36, 0x00, 0xf8, 0x79, 0xa1, 0x15, 0x34, 0xab, 0x0d, 0xca, 0xb5, 0x5f,
-	0x91, 0x73, 0xea, 0x31, 0xe6, 0xfe, 0xfe, 0xe2, 0xe1, 0xe4, 0x8f, 0x15, 0xdd, 0xda, 0x74, 0x84,
-	0xa1, 0xe8, 0xb8, 0xad, 0x80, 0x84, 0x61, 0x05, 0x98, 0x71, 0x3c, 0xa7, 0x49, 0x5a, 0xb5, 0xbd,
-	0x80, 0xb0, 0xaa, 0xd5, 0xb3, 0x1a, 0x35, 0xab, 0x63, 0xb9, 0x36, 0x09, 0xd6, 0xf9, 0x70, 0x65,
-	0x74, 0x02, 0x80, 0x25, 0x21, 0xf3, 0xbd, 0x44, 0xb4, 0xda, 0x09, 0x08, 0x41, 0x1d, 0xc8, 0xbb,
-	0x5e, 0x23, 0x76, 0x28, 0x97, 0x52, 0x70, 0x28, 0x5b, 0x5e, 0x43, 0x6b, 0xbc, 0xd1, 0xaf, 0x10,
-	0x73, 0x26, 0xe6, 0x0f, 0xc9, 0xbc, 0xf7, 0xa6, 0x15, 0xd9, 0xed, 0x0b, 0x7b, 0x34, 0xfb, 0xd9,
-	0x48, 0xf4, 0x22, 0x7e, 0x43, 0xef, 0x45, 0xdc, 0xdf, 0x5f, 0x7c, 0x6e, 0x54, 0x3b, 0xfe, 0x0e,
-	0xa5, 0x50, 0x65, 0x24, 0xb4, 0xb6, 0xc5, 0x3b, 0x30, 0xa5, 0x49, 0x28, 0x9c, 0x56, 0x5a, 0xc5,
-	0x7a, 0x1c, 0x00, 0x35, 0x20, 0xd6, 0xf9, 0x99, 0xdf, 0x66, 0xa0, 0x28, 0xba, 0x80, 0x63, 0x37,
-	0x3f, 0x64, 0xae, 0x91, 0x19, 0x95, 0x6b, 0x20, 0x1f, 0x0a, 0x36, 0xbb, 0x53, 0x10, 0x9e, 0x71,
-	0x92, 0x2c, 0x5f, 0x48, 0xc7, 0xef, 0x28, 0x94, 0x4c, 0xfc, 0x1b, 0x0b, 0x3e, 0xe8, 0x13, 0x03,
-	0x8e, 0xdb, 0x34, 0x89, 0xb4, 0xd5, 0xc1, 0xcd, 0x4d, 0xdc, 0x9a, 0x5b, 0x4d, 0x52, 0xac, 0xfd,
-	0x5c, 0x70, 0x3f, 0x3e, 0x80, 0xc0, 0x83, 0xbc, 0xcd, 0xbf, 0xcd, 0xc2, 0x4c, 0x42, 0x72, 0xf4,
-	0xab, 0x50, 0xea, 0x85, 0x24, 0xd0, 0xb2, 0xb4, 0xb8, 0x7b, 0x73, 0x5d, 0xc0, 0x71, 0x3c, 0x82,
-	0x8e, 0xf6, 0xad, 0x30, 0xbc, 0xe3, 0x05, 0x0d, 0xa1, 0xe7, 0x78, 0xf4, 0xb6, 0x80, 0xe3, 0x78,
-	0x04, 0x4d, 0xe3, 0x6f, 0x11, 0x2b, 0x20, 0xc1, 0x8e, 0xb7, 0x4b, 0x0e, 0x35, 0xb2, 0x6b, 0x0a,
-	0x85, 0xf5, 0x71, 0x4c, 0x69, 0x51, 0x27, 0x5c, 0xed, 0x38, 0xc4, 0x8d, 0xb8, 0x98, 0x29, 0x28,
-	0x6d, 0x67, 0xb3, 0xae, 0x53, 0x54, 0x4a, 0x1b, 0x40, 0xe0, 0x41, 0xde, 0xe8, 0x03, 0x03, 0x66,
-	0xac, 0x3b, 0xa1, 0xba, 0x92, 0xaa, 0xe4, 0x27, 0x36, 0x9f, 0xc4, 0x15, 0x57, 0x6d, 0xee, 0x60,
-	0x7f, 0x31, 0x79, 0xeb, 0x85, 0x93, 0x1c, 0xcd, 0x6f, 0x0c, 0x90, 0x57, 0x5d, 0x4f, 0xa0, 0x49,
-	0xd7, 0x4a, 0x36, 0xe9, 0x6a, 0x93, 0x9f, 0x93, 0x11, 0x0d, 0xba, 0x2d, 0x28, 0xae, 0x7a, 0xdd,
-	0xae, 0xe5, 0x36, 0xd0, 0x2f, 0x41, 0xd1, 0xe6, 0x3f, 0x45, 0xcd, 0xce, 0xda, 0x37, 0x02, 0x8b,
-	0x25, 0x0e, 0x3d, 0x0d, 0x39, 0x2b, 0x10, 0xa5, 0x50, 0x99, 0x77, 0xb7, 0x56, 0x82, 0x56, 0x88,
-	0x19, 0xd4, 0xfc, 0x24, 0x03, 0xb0, 0xea, 0x75, 0x7d, 0x2b, 0x20, 0x8d, 0x1d, 0xef, 0xff, 0x7d,
-	0x21, 0x61, 0x7e, 0x6c, 0x00, 0xa2, 0xfa, 0xf0, 0x5c, 0xe2, 0xaa, 0x3a, 0x16, 0x2d, 0x43, 0xd9,
-	0x96, 0x50, 0x71, 0xea, 0xe3, 0xe4, 0x35, 0x1e, 0x8e, 0xd5, 0x98, 0x31, 0x7c, 0xeb, 0x69, 0xc8,
-	0xb3, 0x9e, 0x8a, 0x38, 0xe5, 0xf1, 0x76, 0xb3, 0xa6, 0x0b, 0xe6, 0x38, 0xf3, 0xd3, 0x0c, 0x9c,
-	0xe2, 0x06, 0x7d, 0xd5, 0x72, 0xad, 0x16, 0xa1, 0x55, 0xfb, 0xb8, 0x95, 0x22, 0x7a, 0x0b, 0x72,
-	0x8e, 0xeb, 0xc8, 0x76, 0xd3, 0x44, 0x36, 0xc9, 0x6d, 0x89, 0x5b, 0xcf, 0xba, 0xeb, 0x44, 0x98,
-	0x51, 0x46, 0x3e, 0x94, 0xe4, 0x6d, 0xb4, 0x88, 0x10, 0x69, 0x70, 0x89, 0x0f, 0xda, 0x25, 0x41,
-	0x1b, 0xc7, 0x5c, 0xcc, 0xbf, 0x33, 0x60, 0xd0, 0x69, 0xb3, 0x78, 0xc7, 0x2f, 0x55, 0x06, 0xe3,
-	0x5d, 0xf2, 0x1a, 0x64, 0xfc, 0x9b, 0x05, 0xf4, 0x06, 0x4c, 0x59, 0x51, 0x44, 0xba, 0x7e, 0xc4,
-	0xf2, 0xb6, 0xec, 0x43, 0xe7, 0x6d, 0xac, 0x14, 0xbc, 0xea, 0x35, 0x9c, 0xa6, 0xc3, 0x72, 0x36,
-	0x9d, 0x9c, 0x69, 0xc1, 0xb4, 0x5e, 0x27, 0x3c, 0x86, 0x05, 0x98, 0x37, 0x60, 0x26, 0xd1, 0x56,
-	0x1b, 0xc3, 0x5c, 0x62, 0x83, 0xcc, 0x3c, 0xc0, 0x20, 0x3f, 0xcf, 0xc0, 0x2c, 0x6b, 0x8e, 0x13,
-	0xdf, 0x0b, 0x1d, 0x56, 0x56, 0x3c, 0x03, 0xd9, 0x5e, 0xd0, 0x11, 0x84, 0xa7, 0xc4, 0xac, 0xec,
-	0x75, 0xbc, 0x89, 0x29, 0x7c, 0x8c, 0x93, 0x60, 0x42, 0xc1, 0xb6, 0xd6, 0xa8, 0x63, 0xa6, 0x7a,
-	0x9e, 0xe6, 0x2d, 0x93, 0xd5, 0x15, 0x0a, 0xc1, 0x02, 0x83, 0xce, 0x40, 0xc9, 0x26, 0x41, 0xc4,
-	0x46, 0xe5, 0xd8, 0xa8, 0x69, 0x6a, 0x21, 0xab, 0x02, 0x86, 0x63, 0x2c, 0x75, 0x8b, 0xbb, 0xa4,
-	0xcf, 0x06, 0xe6, 0xd9, 0x40, 0xde, 0xd5, 0xe6, 0x20, 0x2c, 0x71, 0x89, 0x30, 0x5e, 0x78, 0xa8,
-	0x30, 0x5e, 0x3c, 0x2a, 0x8c, 0x9b, 0xaf, 0x41, 0x69, 0xdd, 0x6d, 0x7a, 0xd4, 0x71, 0xa7, 0xa5,
-	0xf7, 0x3a, 0x94, 0xae, 0xdc, 0xdc, 0xe1, 0xe1, 0xde, 0x84, 0xac, 0x63, 0x71, 0x37, 0x94, 0x55,
-	0x72, 0xac, 0x87, 0x61, 0x8f, 0x99, 0x1a, 0x45, 0xa2, 0xd3, 0x90, 0x25, 0x77, 0x7d, 0x46, 0x32,
-	0xab, 0x5c, 0xd5, 0x85, 0xbb, 0xbe, 0x13, 0x90, 0x90, 0x0e, 0x22, 0x77, 0x7d, 0xb3, 0x07, 0xa0,
-	0x9a, 0x8e, 0x29, 0x49, 0x4a, 0xc9, 0xd8, 0x5e, 0x83, 0xfb, 0x83, 0x92, 0x22, 0xb3, 0xea, 0x35,
-	0x08, 0x66, 0x18, 0xf3, 0x23, 0x03, 0x4e, 0x0c, 0x76, 0x0a, 0x7f, 0x34, 0x0f, 0xfb, 0x3a, 0xcc,
-	0x1d, 0x6a, 0xf1, 0xa5, 0xb5, 0x69, 0x21, 0xa8, 0xcb, 0x51, 0xd4, 0x14, 0xed, 0x0c, 0x63, 0xe2,
-	0x54, 0xa8, 0xde, 0x77, 0x6d, 0x75, 0x07, 0x5b, 0x4a, 0x76, 0x33, 0xcc, 0xcf, 0x73, 0x30, 0x50,
-	0x94, 0xa2, 0x9e, 0x7e, 0xff, 0x6b, 0xa4, 0x78, 0xff, 0x1b, 0xef, 0xd0, 0xb0, 0x3b, 0x60, 0xf4,
-	0x12, 0xe4, 0xfd, 0xb6, 0x15, 0x4a, 0x1d, 0x2d, 0x4a, 0x1d, 0x6d, 0x53, 0xe0, 0x7d, 0xbd, 0x76,
-	0x66, 0x10, 0xcc, 0x47, 0xeb, 0x5e, 0x2e, 0x7b, 0x84, 0x9b, 0x7e, 0x97, 0xf7, 0xf2, 0x30, 0x09,
-	0x7b, 0x9d, 0x48, 0xa4, 0xbc, 0x5b, 0x69, 0x69, 0x96, 0x53, 0x55, 0x4d, 0x3d, 0xfe, 0x8d, 0x35,
-	0x8e, 0xe8, 0xb7, 0xa1, 0x1c, 0x46, 0x56, 0x10, 0x3d, 0x62, 0x23, 0x23, 0x56, 0x5f, 0x5d, 0x12,
-	0xc1, 0x8a, 0x1e, 0x7a, 0x1d, 0xa0, 0xe9, 0xb8, 0x4e, 0xd8, 0x66, 0xd4, 0x8b, 0x8f, 0x16, 0x82,
-	0x2e, 0xc6, 0x14, 0xb0, 0x46, 0xcd, 0xfc, 0x22, 0x03, 0x53, 0xda, 0x9b, 0x95, 0x31, 0x0c, 0x7e,
-	0xe0, 0x8d, 0x4d, 0x66, 0xcc, 0x37, 0x36, 0x67, 0xa0, 0xe4, 0x7b, 0x1d, 0xc7, 0x76, 0xe2, 0x86,
-	0x38, 0xf3, 0xdb, 0xdb, 0x02, 0x86, 0x63, 0x2c, 0x8a, 0xa0, 0x7c, 0xfb, 0x4e, 0xc4, 0x3c, 0x9c,
-	0x7c, 0x91, 0xb3, 0x3a, 0xc9, 0x6d, 0x8a, 0xf0, 0x96, 0x4a, 0xc9, 0x12, 0x12, 0x62, 0xc5, 0x88,
-	0xc6, 0x9e, 0x56, 0xe0, 0xf5, 0x7c, 0xde, 0x0e, 0x13, 0xed, 0x7a, 0xf6, 0x9e, 0x25, 0xc4, 0x02,
-	0x63, 0xfe, 0x65, 0x16, 0x40, 0x8b, 0x77, 0x4b, 0x90, 0x0b, 0x88, 0xef, 0x0d, 0xea, 0x8a, 0x8e,
-	0xc0, 0x0c, 0x93, 0x88, 0x2d, 0x99, 0x87, 0x8a, 0x2d, 0xd9, 0x23, 0x4b, 0xc4, 0x57, 0x61, 0x26,
-	0x0c, 0xdb, 0xdb, 0x81, 0xb3, 0x67, 0x45, 0x64, 0x83, 0xf4, 0xc5, 0xed, 0xf9, 0x49, 0x31, 0x65,
-	0xa6, 0x5e, 0xbf, 0xac, 0x90, 0x38, 0x39, 0x76, 0x68, 0x75, 0x9d, 0xff, 0xf1, 0xaa, 0x6b, 0x54,
-	0x87, 0x93, 0x8e, 0x1b, 0x12, 0xbb, 0x17, 0x88, 0xfe, 0xf5, 0x65, 0x2f, 0x8c, 0xe8, 0xa2, 0x0a,
-	0x2c, 0x78, 0x3c, 0x23, 0x08, 0x9d, 0x5c, 0x1f, 0x36, 0x08, 0x0f, 0x9f, 0xcb, 0x9e, 0xef, 0xa9,
-	0xed, 0xfa, 0xbf, 0xf5, 0x7c, 0x4f, 0xc9, 0x3d, 0xa2, 0xfe, 0xfb, 0xeb, 0x0c, 0x4c, 0xcb, 0xa6,
-	0xd7, 0x9a, 0xd3, 0x6c, 0xd2, 0x40, 0xc4, 0xcc, 0x54, 0x98, 0x63, 0x3c, 0x8b, 0xd9, 0x30, 0xe6,
-	0x38, 0x6a, 0xb2, 0xbb, 0x8e, 0xdb, 0x18, 0x8c, 0x95, 0x1b, 0x8e, 0xdb, 0xc0, 0x0c, 0x93, 0x7c,
-	0x08, 0x93, 0x3d, 0xfa, 0x21, 0x4c, 0xec, 0x31, 0x72, 0x0f, 0xf2, 0x18, 0xfc, 0xe9, 0x86, 0xb2,
-	0x33, 0xcd, 0x63, 0xec, 0x28, 0x14, 0xd6, 0xc7, 0x51, 0x49, 0x3a, 0xce, 0x1e, 0xe1, 0x93, 0x0a,
-	0x49, 0x49, 0x36, 0x25, 0x02, 0xab, 0x31, 0x54, 0x92, 0x86, 0xd3, 0x6c, 0x8a, 0xbc, 0x2c, 0x96,
-	0x84, 0x6a, 0x07, 0x33, 0x8c, 0xf9, 0x1f, 0x06, 0xfc, 0x62, 0xe4, 0x3d, 0x49, 0x5a, 0x1a, 0x94,
-	0x0a, 0xc9, 0x8e, 0x54, 0x48, 0x42, 0xc7, 0xb9, 0x31, 0x74, 0xfc, 0x22, 0x4c, 0xdf, 0x0e, 0x3d,
-	0x77, 0xdb, 0x73, 0x5c, 0x76, 0x41, 0xcb, 0x5d, 0xd4, 0x89, 0x83, 0xfd, 0xc5, 0xe9, 0x2b, 0xf5,
-	0x6b, 0x5b, 0x12, 0x8e, 0x13, 0xa3, 0xcc, 0x8f, 0xf2, 0x70, 0x2a, 0xee, 0x8b, 0x92, 0xe8, 0x8e,
-	0x17, 0xec, 0x3a, 0x6e, 0x8b, 0x26, 0xa4, 0xe8, 0x33, 0x03, 0xa6, 0xb9, 0xae, 0x37, 0xad, 0x5b,
-	0xa4, 0x23, 0x3b, 0xb0, 0x76, 0x1a, 0x1d, 0xd8, 0x04, 0xa7, 0xea, 0x8e, 0xc6, 0xe5, 0x82, 0x1b,
-	0x05, 0x7d, 0xd5, 0xb5, 0xd7, 0x51, 0x38, 0x21, 0x0e, 0xba, 0x0b, 0x65, 0xf9, 0xda, 0xa7, 0x99,
-	0xc2, 0x7b, 0x27, 0x29, 0x1b, 0x26, 0x4d, 0xd5, 0x48, 0x97, 0xcf, 0x8b, 0x9a, 0x21, 0x56, 0xcc,
-	0xd0, 0x87, 0x06, 0x14, 0x3a, 0x5c, 0x27, 0x59, 0xc6, 0xf7, 0x77, 0xd2, 0xd7, 0x89, 0xae, 0x8d,
-	0xb8, 0xc4, 0x13, 0x7a, 0x10, 0xcc, 0xf5, 0x16, 0x7c, 0x2e, 0xa5, 0x16, 0xfc, 0xfc, 0x6f, 0xc1,
-	0xdc, 0xa1, 0xed, 0x40, 0x27, 0x20, 0xbb, 0x4b, 0xfa, 0xdc, 0xe6, 0x31, 0xfd, 0x89, 0x9e, 0x4a,
-	0xa4, 0xb4, 0x22, 0x87, 0x7d, 0x25, 0x73, 0xde, 0x98, 0x7f, 0x19, 0xa6, 0x1e, 0x71, 0xaa, 0xf9,
-	0x6f, 0x39, 0xe5, 0xaf, 0xb6, 0xbc, 0x06, 0xeb, 0x93, 0x07, 0x6a, 0x5b, 0x84, 0x37, 0x4e, 0x6b,
-	0x93, 0x63, 0xef, 0xa2, 0x01, 0xb1, 0xce, 0x0f, 0xdd, 0x63, 0x2f, 0x1e, 0x68, 0x29, 0x41, 0x9a,
-	0xe1, 0xe3, 0x32, 0xb1, 0xed, 0x98, 0x03, 0xd6, 0xb8, 0x21, 0x02, 0x39, 0xc7, 0x6d, 0x7a, 0xc2,
-	0xc0, 0x26, 0x49, 0x6e, 0x64, 0x75, 0xa9, 0xdc, 0x0c, 0x85, 0x60, 0x46, 0x9e, 0x06, 0xf9, 0x59,
-	0x37, 0x61, 0x79, 0x22, 0x33, 0x7e, 0x2d, 0x75, 0x93, 0xe6, 0x57, 0x60, 0x49, 0x18, 0x1e, 0x60,
-	0x8e, 0x56, 0xe0, 0xb8, 0xdc, 0x81, 0x1b, 0x24, 0x60, 0x2f, 0xfe, 0x78, 0x2c, 0x88, 0xf3, 0x04,
-	0x9c, 0x44, 0xe3, 0xc1, 0xf1, 0xda, 0xa3, 0x8a, 0xc2, 0xc8, 0x47, 0x15, 0x1f, 0x1b, 0x70, 0x42,
-	0x12, 0xba, 0xb6, 0x47, 0x82, 0xc0, 0x69, 0x30, 0x97, 0xcb, 0xef, 0x5c, 0x37, 0x7b, 0xd6, 0x60,
-	0x55, 0x79, 0x59, 0x22, 0xb0, 0x1a, 0x83, 0x2e, 0x0d, 0xbb, 0xa5, 0xe7, 0x4e, 0xff, 0xe1, 0xee,
-	0xd3, 0xbf, 0x36, 0x40, 0xb7, 0xc2, 0xf1, 0xa2, 0xcc, 0xf3, 0x50, 0xdc, 0x13, 0x2a, 0x1a, 0x68,
-	0xf0, 0x48, 0xd5, 0x48, 0x7c, 0x1c, 0x90, 0xb2, 0xe3, 0x85, 0xf4, 0xdc, 0x43, 0x84, 0xf4, 0xfc,
-	0xc8, 0xb7, 0x27, 0x7f, 0x93, 0xa5, 0xa9, 0x95, 0x5c, 0x14, 0x2b, 0x81, 0x7e, 0x0a, 0xeb, 0x42,
-	0x2f, 0xc6, 0x0d, 0x38, 0x9e, 0x70, 0x3c, 0x9d, 0x6c, 0xc0, 0xdd, 0xdf, 0x5f, 0x04, 0xbe, 0x5c,
-	0xd6, 0xc5, 0x18, 0xd2, 0x8e, 0x2b, 0x1e, 0x51, 0xa8, 0x9e, 0x87, 0x52, 0xdb, 0xf3, 0x76, 0xd9,
-	0x25, 0x6d, 0x29, 0xc1, 0xa2, 0x74, 0x59, 0xc0, 0xef, 0x6b, 0xbf, 0x71, 0x3c, 0x1a, 0xad, 0x40,
-	0x99, 0xfe, 0x66, 0x15, 0xb2, 0xb8, 0xdf, 0x3d, 0x1d, 0x5b, 0xb0, 0x44, 0x0c, 0x29, 0xa6, 0xd5,
-	0x2c, 0xf3, 0x73, 0x6d, 0xd7, 0x44, 0xc7, 0xf1, 0x27, 0xb1, 0x6b, 0xe7, 0x07, 0x76, 0x6d, 0xe9,
-	0xd0, 0xae, 0xcd, 0xaa, 0x97, 0x1f, 0x89, 0x9d, 0x53, 0x2f, 0x3e, 0x8a, 0x4f, 0xe6, 0xc5, 0xc7,
-	0x12, 0xe4, 0xe8, 0x7e, 0xb0, 0xbd, 0xd7, 0x9a, 0x62, 0x74, 0x03, 0x31, 0xc3, 0x98, 0x7f, 0x95,
-	0x81, 0xe3, 0x03, 0x4f, 0x39, 0x68, 0x65, 0x18, 0xc8, 0x47, 0xd2, 0x03, 0x75, 0x64, 0xfc, 0x3c,
-	0x3a, 0x1e, 0x81, 0xde, 0x04, 0x68, 0x10, 0xbf, 0xe3, 0xf5, 0x59, 0xbf, 0x20, 0xf7, 0xe8, 0x4f,
-	0x0d, 0xd6, 0x62, 0x2a, 0x58, 0xa3, 0x88, 0xe6, 0x21, 0xe3, 0x34, 0xd8, 0x76, 0x64, 0x6b, 0x20,
-	0xc6, 0x66, 0xd6, 0xd7, 0x70, 0xc6, 0x69, 0x68, 0xf7, 0x46, 0x85, 0x27, 0x77, 0x6f, 0x64, 0xfe,
-	0x4f, 0x16, 0x66, 0x12, 0x2d, 0x9b, 0x84, 0xc6, 0x8c, 0x23, 0x35, 0x76, 0x1a, 0xf2, 0x7e, 0xd0,
-	0x73, 0x79, 0xda, 0x52, 0x52, 0xa7, 0x60, 0x9b, 0x02, 0x31, 0xc7, 0xa1, 0x67, 0xa1, 0xd0, 0x08,
-	0xfa, 0xb8, 0xe7, 0x8a, 0x8e, 0x66, 0x2c, 0xcc, 0x1a, 0x83, 0x62, 0x81, 0x45, 0xef, 0xc0, 0x74,
-	0xc8, 0xac, 0x2d, 0xb0, 0x22, 0xd2, 0x92, 0x2f, 0xcb, 0x2e, 0x4d, 0xfc, 0x6c, 0x89, 0x93, 0xe3,
-	0x59, 0xbf, 0x0e, 0xc1, 0x09, 0x76, 0xe8, 0x03, 0x43, 0x7f, 0xaa, 0xc5, 0xff, 0xbe, 0xb3, 0x9d,
-	0x62, 0x2b, 0x8c, 0xef, 0xc4, 0x83, 0x5f, 0x6c, 0xf9, 0xb1, 0x15, 0x14, 0x1f, 0x83, 0x15, 0xc0,
-	0x10, 0x0b, 0x78, 0xdf, 0x80, 0x93, 0x43, 0x25, 0x7d, 0x62, 0x55, 0x1d, 0x3d, 0xb8, 0x3f, 0x1b,
-	0xd2, 0x37, 0x44, 0x7b, 0x8f, 0xe7, 0xe9, 0x9c, 0xe8, 0x4a, 0xce, 0x8c, 0xdc, 0x84, 0x87, 0x73,
-	0x1a, 0xea, 0xe0, 0x66, 0x9f, 0xe0, 0xc1, 0xfd, 0x63, 0x03, 0xb4, 0x77, 0x92, 0xe8, 0xf7, 0xa0,
-	0x6c, 0xf5, 0x22, 0xaf, 0x6b, 0x45, 0xa4, 0x21, 0x2a, 0x82, 0xad, 0x54, 0x5e, 0x64, 0xae, 0x48,
-	0xaa, 0x5c, 0x5f, 0xf1, 0x27, 0x56, 0xfc, 0xcc, 0x57, 0xf8, 0xf6, 0x0d, 0x4c, 0x50, 0xbe, 0xc1,
-	0x18, 0xed, 0x1b, 0xcc, 0xff, 0x14, 0xeb, 0x10, 0x51, 0xf5, 0xfc, 0xc0, 0x3d, 0xde, 0xf8, 0x01,
-	0xa9, 0x0f, 0x60, 0xc7, 0xb7, 0xf0, 0x29, 0xbc, 0x78, 0x54, 0x57, 0xfa, 0xfa, 0x7b, 0x3c, 0x09,
-	0xc3, 0x1a, 0xb3, 0x84, 0xbd, 0x64, 0x8f, 0xb2, 0x17, 0xf3, 0xdf, 0x0d, 0x48, 0x78, 0x21, 0xd4,
-	0x85, 0x3c, 0x95, 0xa0, 0x9f, 0xc2, 0x83, 0x01, 0x9d, 0x2e, 0xb5, 0xa5, 0x7e, 0xad, 0x4c, 0x35,
-	0xce, 0x7e, 0x62, 0xce, 0x05, 0x39, 0x22, 0x90, 0x72, 0x15, 0x6d, 0xa4, 0xc4, 0x8d, 0xc6, 0x61,
-	0xf1, 0xc7, 0x1f, 0x15, 0x91, 0xcf, 0xc3, 0xdc, 0x21, 0x89, 0xa8, 0x59, 0x34, 0x3d, 0xf9, 0x3e,
-	0x42, 0x33, 0x8b, 0x8b, 0x14, 0x88, 0x39, 0xce, 0xfc, 0xc2, 0x80, 0x13, 0x83, 0xe4, 0xd1, 0x9f,
-	0x1b, 0x30, 0x17, 0x0e, 0xd2, 0x7b, 0x2c, 0x5a, 0x8b, 0x0b, 0x95, 0x43, 0x28, 0x7c, 0x58, 0x02,
-	0xba, 0xa3, 0x83, 0x2f, 0x7a, 0xa8, 0x4d, 0xc8, 0xde, 0xaa, 0x58, 0xa8, 0xba, 0x66, 0x14, 0x70,
-	0x1c, 0x8f, 0x40, 0xe7, 0x00, 0xf8, 0x8b, 0xb2, 0x2d, 0xd5, 0xf0, 0x8e, 0xad, 0xae, 0x1e, 0x63,
-	0xb0, 0x36, 0x2a, 0x71, 0x9f, 0x9b, 0x1d, 0xf7, 0x3e, 0x37, 0xf7, 0x80, 0xfb, 0x5c, 0x75, 0x89,
-	0x9c, 0x1f, 0x75, 0x89, 0x5c, 0xab, 0x7e, 0xf9, 0xfd, 0xc2, 0xb1, 0xaf, 0xbe, 0x5f, 0x38, 0xf6,
-	0xdd, 0xf7, 0x0b, 0xc7, 0xde, 0x3f, 0x58, 0x30, 0xbe, 0x3c, 0x58, 0x30, 0xbe, 0x3a, 0x58, 0x30,
-	0xbe, 0x3b, 0x58, 0x30, 0xfe, 0xf5, 0x60, 0xc1, 0xf8, 0xd3, 0x1f, 0x16, 0x8e, 0xbd, 0x5e, 0x92,
-	0xaa, 0xfd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x67, 0xd4, 0xee, 0xd2, 0x40, 0x00, 0x00,
+	// 4912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe5, 0x3c, 0x5b, 0x6c, 0x24, 0xd9,
+	0x55, 0x5b, 0xfd, 0xb0, 0xdb, 0xb7, 0x6d, 0xcf, 0xf8, 0xce, 0x63, 0x9d, 0x51, 0xb2, 0x33, 0xa9,
+	0x88, 0x64, 0x81, 0xc4, 0x66, 0x47, 0x01, 0x26, 0x04, 0x81, 0xdc, 0xf6, 0x3c, 0x3c, 0xe3, 0xf1,
+	0xf4, 0x9e, 0xf6, 0xee, 0xa0, 0x0d, 0x84, 0xd4, 0x74, 0x97, 0xbb, 0x6b, 0xdd, 0xae, 0xea, 0xad,
+	0xaa, 0xf6, 0x8c, 0x97, 0x64, 0x09, 0x90, 0x20, 0x94, 0x6c, 0xa2, 0x20, 0x02, 0x3f, 0x28, 0x22,
+	0x5a, 0x89, 0x9f, 0xc0, 0x57, 0xa4, 0x20, 0xbe, 0x91, 0x10, 0xfb, 0x19, 0x21, 0x40, 0x11, 0x8f,
+	0x15, 0x24, 0x42, 0x20, 0xf1, 0xc1, 0x07, 0xe2, 0x67, 0x85, 0x80, 0x7b, 0xee, 0xbb, 0xaa, 0xbb,
+	0xc7, 0xf6, 0x74, 0x8d, 0x57, 0x84, 0x8f, 0xd9, 0xed, 0xba, 0xe7, 0xd6, 0x39, 0xf7, 0x71, 0xde,
+	0xe7, 0x94, 0xc9, 0x66, 0x37, 0x48, 0x7b, 0xc3, 0x07, 0x2b, 0xed, 0x68, 0x7f, 0xd5, 0x8b, 0xbb,
+	0xd1, 0x20, 0x8e, 0x5e, 0xe5, 0x3f, 0x3e, 0xd6, 0xee, 0xac, 0x0e, 0xf6, 0xba, 0xab, 0xde, 0x20,
+	0x48, 0xd8, 0x7f, 0x06, 0xfd, 0xa0, 0xed, 0xa5, 0x41, 0x14, 0xae, 0x1e, 0xbc, 0xe0, 0xf5, 0x07,
+	0x3d, 0xef, 0x85, 0xd5, 0xae, 0x1f, 0xfa, 0xb1, 0x97, 0xfa, 0x9d, 0x15, 0xf6, 0x52, 0x1a, 0xd1,
+	0x4f, 0x18, 0x54, 0x2b, 0x0a, 0x15, 0xff, 0xf1, 0xcb, 0x6d, 0x36, 0x65, 0xaf, 0xbb, 0x82, 0xa8,
+	0x56, 0x2c, 0x54, 0x2b, 0x0a, 0xd5, 0xa5, 0x8f, 0x59, 0xab, 0xe8, 0x46, 0xdd, 0x68, 0x95, 0x63,
+	0x7c, 0x30, 0xdc, 0xe5, 0x4f, 0xfc, 0x81, 0xff, 0x12, 0x94, 0x2e, 0xb9, 0x7b, 0xd7, 0x92, 0x95,
+	0x20, 0xc2, 0xb5, 0xad, 0xb6, 0xa3, 0xd8, 0x67, 0x6b, 0xca, 0xaf, 0xe6, 0xd2, 0xc7, 0xcd, 0x9c,
+	0x7d, 0xaf, 0xdd, 0x0b, 0x18, 0xf4, 0xd0, 0x6c, 0x68, 0xdf, 0x4f, 0xbd, 0x71, 0x6f, 0xad, 0x4e,
+	0x7a, 0x2b, 0x1e, 0x86, 0x69, 0xb0, 0xef, 0x8f, 0xbc, 0xf0, 0x53, 0x47, 0xbd, 0x90, 0xb4, 0x7b,
+	0xfe, 0xbe, 0x97, 0x7f, 0xcf, 0x7d, 0x8d, 0x2c, 0xac, 0xdd, 0x6f, 0xad, 0x0d, 0xd3, 0xde, 0x7a,
+	0x14, 0xee, 0x06, 0x5d, 0xfa, 0x93, 0xa4, 0xde, 0xee, 0x0f, 0x93, 0xd4, 0x8f, 0xb7, 0xbd, 0x7d,
+	0x7f, 0xd9, 0xb9, 0xe2, 0x3c, 0x3f, 0xd7, 0x38, 0xf7, 0xf6, 0x3b, 0x97, 0x9f, 0xf9, 0xfe, 0x3b,
+	0x97, 0xeb, 0xeb, 0x06, 0x04, 0xf6, 0x3c, 0xfa, 0xa3, 0x64, 0x36, 0x8e, 0xfa, 0xfe, 0x1a, 0x6c,
+	0x2f, 0x97, 0xf8, 0x2b, 0x67, 0xe4, 0x2b, 0xb3, 0x20, 0x86, 0x41, 0xc1, 0xdd, 0xbf, 0x73, 0x08,
+	0x59, 0x1b, 0x0c, 0x9a, 0xec, 0x5a, 0xfc, 0x76, 0x4a, 0x3f, 0x43, 0x6a, 0x78, 0x0a, 0x1d, 0x2f,
+	0xf5, 0x38, 0xb5, 0xfa, 0xd5, 0x9f, 0x58, 0x11, 0x9b, 0x59, 0xb1, 0x37, 0x63, 0x6e, 0x0e, 0x67,
+	0xb3, 0x2b, 0x5b, 0xb9, 0xf7, 0x00, 0xdf, 0xbf, 0xcb, 0x9e, 0x1a, 0x54, 0x12, 0x23, 0x66, 0x0c,
+	0x34, 0x56, 0xba, 0x47, 0x2a, 0xc9, 0xc0, 0x6f, 0xf3, 0x85, 0xd5, 0xaf, 0x6e, 0xae, 0x3c, 0x31,
+	0x7f, 0xac, 0x98, 0x65, 0xb7, 0x18, 0xc2, 0xc6, 0xbc, 0x24, 0x5b, 0xc1, 0x27, 0xe0, 0x44, 0xdc,
+	0xbf, 0x75, 0xc8, 0xa2, 0x99, 0xb6, 0x15, 0x24, 0x29, 0xfd, 0xc5, 0x91, 0x1d, 0xae, 0x1c, 0x6f,
+	0x87, 0xf8, 0x36, 0xdf, 0xdf, 0x59, 0x49, 0xa8, 0xa6, 0x46, 0xac, 0xdd, 0xbd, 0x4a, 0xaa, 0x41,
+	0xea, 0xef, 0x27, 0x6c, 0x7b, 0x65, 0x86, 0xfa, 0x7a, 0x21, 0xdb, 0x6b, 0x2c, 0x48, 0x8a, 0xd5,
+	0x4d, 0xc4, 0x0d, 0x82, 0x84, 0xfb, 0xdf, 0xb3, 0xf6, 0xe6, 0x70, 0xd7, 0xf4, 0x05, 0x52, 0x4f,
+	0xa2, 0x61, 0xdc, 0xf6, 0xc1, 0x1f, 0x44, 0x09, 0xdb, 0x5f, 0x19, 0x2f, 0x1f, 0x79, 0xa5, 0x65,
+	0x86, 0xc1, 0x9e, 0x43, 0xbf, 0xec, 0x90, 0xf9, 0x8e, 0x9f, 0xa4, 0x41, 0xc8, 0xe9, 0xab, 0x95,
+	0xbf, 0x38, 0xdd, 0xca, 0xd5, 0xe0, 0x86, 0xc1, 0xdc, 0x38, 0x2f, 0x77, 0x31, 0x6f, 0x0d, 0x26,
+	0x90, 0x21, 0x8e, 0x0c, 0xcf, 0x9e, 0xdb, 0x71, 0x30, 0xc0, 0xe7, 0xe5, 0x72, 0x96, 0xe1, 0x37,
+	0x0c, 0x08, 0xec, 0x79, 0x8c, 0xa9, 0xaa, 0xc8, 0xd0, 0xc9, 0x72, 0x85, 0x2f, 0xfe, 0xc6, 0x14,
+	0x8b, 0x97, 0xc7, 0x89, 0x82, 0x62, 0xce, 0x1d, 0x9f, 0xd8, 0xb9, 0x73, 0x1a, 0xf4, 0x2b, 0x0e,
+	0x59, 0x96, 0xd2, 0x06, 0xbe, 0x38, 0xca, 0xfb, 0x3d, 0x76, 0x25, 0x7d, 0xc6, 0x0e, 0xcb, 0x55,
+	0xbe, 0x80, 0xd5, 0xe3, 0xb1, 0xd4, 0xcd, 0x38, 0x1a, 0x0e, 0xee, 0x04, 0x61, 0xa7, 0x71, 0x45,
+	0x52, 0x5a, 0x5e, 0x9f, 0x80, 0x18, 0x26, 0x92, 0xa4, 0xbf, 0xe3, 0x90, 0x4b, 0x21, 0x13, 0xfb,
+	0x64, 0xe0, 0xe1, 0xa5, 0x0a, 0x70, 0xa3, 0xef, 0xb5, 0xf7, 0xf8, 0x8a, 0x66, 0x9e, 0x6c, 0x45,
+	0xae, 0x5c, 0xd1, 0xa5, 0xed, 0x89, 0xa8, 0xe1, 0x31, 0x64, 0xe9, 0x23, 0xc6, 0x8a, 0x87, 0x61,
+	0xfb, 0x3e, 0xc3, 0x15, 0x3d, 0x4c, 0x96, 0x67, 0xa7, 0x96, 0x87, 0x96, 0xc6, 0x26, 0x39, 0xda,
+	0x60, 0x07, 0x9b, 0x14, 0xdd, 0x20, 0x67, 0xbd, 0x61, 0x1a, 0x21, 0x7c, 0x23, 0x48, 0xbc, 0x07,
+	0x7d, 0xbf, 0xb3, 0x5c, 0x63, 0x8c, 0x54, 0x6b, 0x2c, 0xcb, 0x3d, 0x9d, 0x5d, 0xcb, 0xc1, 0x61,
+	0xe4, 0x0d, 0xfa, 0x4d, 0x87, 0x2c, 0x45, 0x31, 0xa3, 0x1c, 0x32, 0xb0, 0xdc, 0x5d, 0xb2, 0x3c,
+	0xc7, 0x35, 0xc6, 0xa7, 0xa6, 0xd8, 0xc6, 0xbd, 0x3c, 0xce, 0xbb, 0x51, 0x18, 0xa4, 0x51, 0xdc,
+	0xf2, 0x53, 0x26, 0x06, 0xdd, 0xa4, 0x71, 0x81, 0x2d, 0x70, 0x69, 0x64, 0x16, 0x8c, 0x2e, 0xc6,
+	0xfd, 0x87, 0x0a, 0xa9, 0x5b, 0xb2, 0x76, 0x0a, 0xca, 0xbb, 0x9f, 0x51, 0xde, 0xb7, 0x8b, 0xd1,
+	0x11, 0x93, 0xb4, 0x37, 0x4d, 0xc9, 0x4c, 0x92, 0x7a, 0xe9, 0x30, 0xe1, 0x7a, 0xa0, 0x7e, 0x75,
+	0xab, 0x20, 0x7a, 0x1c, 0x67, 0x63, 0x51, 0x52, 0x9c, 0x11, 0xcf, 0x20, 0x69, 0xd1, 0xd7, 0xc8,
+	0x5c, 0x34, 0x40, 0xb3, 0x8c, 0x0a, 0xa8, 0xc2, 0x09, 0x6f, 0x4c, 0x73, 0xdf, 0x0a, 0x57, 0x63,
+	0x81, 0x11, 0x9b, 0xd3, 0x8f, 0x60, 0xa8, 0xd0, 0xaf, 0x33, 0x5e, 0x1b, 0xf8, 0x61, 0x87, 0xdd,
+	0xbf, 0x86, 0x27, 0x52, 0x95, 0xdc, 0x99, 0x46, 0x97, 0xe5, 0x70, 0x36, 0xde, 0x27, 0xf7, 0xbc,
+	0x94, 0x87, 0x30, 0xfe, 0x1a, 0x59, 0x80, 0xdb, 0x26, 0xe7, 0xad, 0x63, 0x63, 0x2e, 0x49, 0x27,
+	0xe0, 0xcb, 0xbd, 0x42, 0x2a, 0xe9, 0xe1, 0x40, 0xb9, 0x23, 0xfa, 0xe6, 0x76, 0xd8, 0x18, 0x70,
+	0x08, 0x3a, 0x20, 0x4c, 0x31, 0x24, 0x5e, 0xd7, 0xcf, 0x3b, 0x20, 0x77, 0xc5, 0x30, 0x28, 0x38,
+	0xf3, 0x79, 0x2e, 0x8e, 0xb7, 0x17, 0xf4, 0xc3, 0xec, 0xfa, 0xfd, 0xf8, 0xc0, 0x8f, 0x25, 0x21,
+	0x73, 0x61, 0x7c, 0x14, 0x24, 0x94, 0xae, 0x92, 0x39, 0xad, 0x87, 0x24, 0xb9, 0x25, 0x39, 0x75,
+	0xce, 0x28, 0x2f, 0x33, 0xc7, 0xfd, 0x7b, 0x87, 0x9c, 0xb1, 0x68, 0x9e, 0x82, 0x5b, 0xb0, 0x97,
+	0x75, 0x0b, 0x6e, 0x14, 0xc3, 0xc8, 0x13, 0xfc, 0x82, 0xaf, 0xce, 0x90, 0x25, 0x9b, 0xdd, 0xb9,
+	0xb6, 0xe0, 0x3e, 0x21, 0x33, 0xf8, 0x2f, 0xc1, 0x96, 0x3c, 0x4e, 0xe3, 0x13, 0x8a, 0x61, 0x50,
+	0x70, 0xbc, 0xdf, 0x81, 0x97, 0xf6, 0xe4, 0x59, 0xea, 0xfb, 0x6d, 0xb2, 0x31, 0xe0, 0x10, 0xfa,
+	0x73, 0x64, 0x31, 0x65, 0xcb, 0xf5, 0x53, 0xf0, 0x0f, 0x82, 0x44, 0x09, 0xca, 0x5c, 0xe3, 0xa2,
+	0x9c, 0xbb, 0xb8, 0x93, 0x81, 0x42, 0x6e, 0x36, 0x0d, 0x49, 0xa5, 0xe7, 0xf7, 0xf7, 0x99, 0x55,
+	0xc0, 0x93, 0x6e, 0x16, 0x24, 0xd7, 0x7c, 0xa3, 0xb7, 0x18, 0xde, 0x46, 0x0d, 0xd7, 0x8b, 0xbf,
+	0x80, 0xd3, 0xa1, 0xbf, 0xee, 0x90, 0xb9, 0x3d, 0x66, 0x3e, 0xa3, 0xfd, 0xe0, 0x75, 0x9f, 0x1b,
+	0x83, 0xfa, 0xd5, 0x97, 0x8a, 0xa4, 0x7a, 0x47, 0x21, 0x17, 0x52, 0xae, 0x1f, 0xc1, 0x90, 0xa5,
+	0xaf, 0x93, 0xd9, 0xbd, 0x24, 0x0a, 0x43, 0x3f, 0x95, 0x66, 0xa4, 0x55, 0xe8, 0x0a, 0x04, 0xea,
+	0x46, 0x1d, 0xaf, 0x54, 0x3e, 0x80, 0x22, 0xc8, 0x0f, 0xa0, 0x13, 0xc4, 0x4c, 0xa3, 0x47, 0xf1,
+	0xe1, 0x32, 0x29, 0xfe, 0x00, 0x36, 0x14, 0x72, 0x71, 0x00, 0xfa, 0x11, 0x0c, 0x59, 0x7a, 0x40,
+	0x66, 0x06, 0xfd, 0x61, 0x37, 0x08, 0x97, 0xeb, 0x7c, 0x01, 0x50, 0xe4, 0x02, 0x9a, 0x1c, 0x73,
+	0x83, 0xa0, 0x82, 0x10, 0xbf, 0x41, 0x52, 0x73, 0xff, 0x82, 0x39, 0x48, 0x93, 0x17, 0x2c, 0x24,
+	0xa3, 0x3d, 0x8c, 0x13, 0xa1, 0xd1, 0x6a, 0xb6, 0x64, 0xf0, 0x61, 0x50, 0x70, 0xfa, 0x06, 0x99,
+	0x7d, 0x55, 0x5e, 0x61, 0xa9, 0xf8, 0x2b, 0xbc, 0x2d, 0xaf, 0x50, 0xd3, 0xbf, 0xad, 0xae, 0x51,
+	0x12, 0x75, 0xff, 0xdc, 0x21, 0x17, 0xc6, 0x72, 0x3c, 0x5d, 0x21, 0xe4, 0xc0, 0xeb, 0x0f, 0xfd,
+	0x1b, 0x01, 0xba, 0xc1, 0xc2, 0xf1, 0x5f, 0x44, 0x3b, 0xfe, 0xb2, 0x1e, 0x05, 0x6b, 0x06, 0xfd,
+	0x2c, 0x21, 0x03, 0x2f, 0x66, 0x2a, 0x91, 0xb9, 0x94, 0x4a, 0x2d, 0xdd, 0x9a, 0x62, 0x33, 0xb8,
+	0x88, 0xa6, 0x42, 0x68, 0xbc, 0x08, 0x3d, 0xc4, 0xa8, 0x1b, 0x7a, 0xee, 0x7f, 0x32, 0x17, 0x7a,
+	0xd2, 0xf6, 0xe9, 0x80, 0xcc, 0xfa, 0x8f, 0xd2, 0x97, 0xbd, 0x58, 0xec, 0x63, 0x3a, 0xaf, 0x51,
+	0x22, 0x65, 0xd8, 0xcc, 0xb1, 0x5e, 0x17, 0xd8, 0x41, 0x91, 0xa1, 0x5d, 0x66, 0xd0, 0xfa, 0x5e,
+	0x11, 0x41, 0x9b, 0x45, 0xce, 0xd8, 0xc5, 0xad, 0xb5, 0x04, 0x38, 0x01, 0xf7, 0x2f, 0xc7, 0xed,
+	0x5b, 0x0a, 0x2b, 0xc6, 0x3e, 0x7e, 0x78, 0x10, 0xc4, 0x51, 0xb8, 0xef, 0x87, 0x69, 0x3e, 0xd8,
+	0xbf, 0x6e, 0x40, 0x60, 0xcf, 0xa3, 0xbf, 0x3a, 0xe6, 0x26, 0xa7, 0x71, 0x1a, 0xe4, 0x72, 0x8e,
+	0x7f, 0x99, 0xff, 0x31, 0x4e, 0xbc, 0xb4, 0x06, 0xa4, 0x57, 0x09, 0x41, 0xd3, 0xdb, 0x8c, 0xfd,
+	0xdd, 0xe0, 0x91, 0xdc, 0x95, 0x46, 0xb9, 0xad, 0x21, 0x60, 0xcd, 0xa2, 0x9f, 0x23, 0x73, 0xcc,
+	0xe6, 0x76, 0xfd, 0x1d, 0xaf, 0xab, 0xb6, 0x34, 0x8d, 0xf3, 0xa7, 0x17, 0xb3, 0x29, 0x91, 0x1a,
+	0x07, 0x41, 0x8d, 0x24, 0x60, 0x28, 0x52, 0x97, 0xcc, 0xf0, 0x07, 0x74, 0x3c, 0x51, 0x90, 0xb8,
+	0x52, 0xe1, 0x33, 0x99, 0x9b, 0x28, 0x20, 0xee, 0x27, 0xc9, 0xb3, 0x13, 0x74, 0x10, 0xda, 0xcf,
+	0xd0, 0xa4, 0x6b, 0x34, 0x1f, 0xf0, 0x3c, 0x0d, 0x87, 0xb8, 0x6f, 0x55, 0x33, 0x1e, 0x48, 0x4b,
+	0x79, 0xbb, 0x1c, 0x8b, 0xf4, 0x3f, 0xb6, 0x8a, 0x54, 0x2d, 0x96, 0xf3, 0x24, 0x62, 0x7f, 0x49,
+	0x8b, 0xfe, 0x96, 0xc3, 0x23, 0x6e, 0xe5, 0x74, 0x49, 0xb5, 0xf6, 0x14, 0xa2, 0x7f, 0x3b, 0x88,
+	0x57, 0x83, 0x60, 0x93, 0x46, 0x3d, 0x3c, 0x10, 0xc1, 0xb7, 0x8c, 0xfb, 0xb5, 0xc0, 0xaa, 0x98,
+	0x5c, 0xc1, 0xe9, 0x90, 0x10, 0x8c, 0xf8, 0x9a, 0x11, 0xa3, 0x74, 0x28, 0x9d, 0xf4, 0x69, 0x63,
+	0x4b, 0x81, 0x4c, 0x28, 0x4d, 0xf3, 0x0c, 0x16, 0x21, 0xfa, 0x0d, 0xe6, 0xa7, 0x07, 0xdd, 0x30,
+	0x8a, 0x99, 0xf5, 0xd8, 0xdd, 0xf5, 0x63, 0x3f, 0xc4, 0x98, 0x50, 0xf8, 0xe9, 0x3b, 0x53, 0x90,
+	0x57, 0x21, 0xdd, 0x66, 0x1e, 0xb7, 0x71, 0xd8, 0x47, 0x40, 0x30, 0xba, 0x12, 0xba, 0x45, 0xce,
+	0xc7, 0xd2, 0xc5, 0xba, 0xc5, 0x9c, 0x50, 0x66, 0xdc, 0xb6, 0x82, 0xfd, 0x00, 0x53, 0x00, 0xce,
+	0xf3, 0xe5, 0xc6, 0x32, 0xc3, 0x73, 0x1e, 0xc6, 0xc0, 0x61, 0xec, 0x5b, 0xee, 0x77, 0xea, 0x59,
+	0x3f, 0x52, 0x84, 0x47, 0xaf, 0x93, 0xb9, 0x58, 0x87, 0xc3, 0x42, 0x3f, 0x6f, 0x16, 0xb0, 0x75,
+	0x19, 0x94, 0x69, 0xb9, 0x34, 0x81, 0xaf, 0x21, 0x87, 0x7a, 0x1a, 0x6f, 0x43, 0x32, 0xe9, 0xb4,
+	0x17, 0x2e, 0x49, 0x9a, 0xc8, 0x93, 0x8d, 0x01, 0x27, 0x40, 0x23, 0x32, 0xd3, 0xf3, 0xbd, 0x3e,
+	0xf3, 0x81, 0x45, 0xe4, 0x79, 0x73, 0x2a, 0xcb, 0x88, 0x88, 0xf2, 0x41, 0xa7, 0x18, 0x05, 0x49,
+	0x86, 0x31, 0xf4, 0x6c, 0x4f, 0x9c, 0xbd, 0x4c, 0x61, 0xdd, 0x9e, 0xea, 0x4c, 0x33, 0xb7, 0x69,
+	0xe4, 0x48, 0x0e, 0x80, 0xa2, 0x45, 0x7f, 0xc3, 0x21, 0xa4, 0xad, 0xe2, 0x3a, 0xc5, 0xc9, 0xf7,
+	0x8a, 0x11, 0x7e, 0x1d, 0x2f, 0x1a, 0x6d, 0xaf, 0x87, 0x98, 0x01, 0x31, 0x64, 0x69, 0x87, 0xcc,
+	0x33, 0x07, 0x2b, 0x0a, 0xdb, 0xcc, 0x33, 0xe9, 0xac, 0x09, 0x76, 0xad, 0x5f, 0xfd, 0xb1, 0xe3,
+	0xc5, 0x5f, 0x3b, 0xc1, 0xbe, 0x6f, 0x52, 0x8b, 0x60, 0xe1, 0x81, 0x0c, 0x56, 0xfa, 0x45, 0x87,
+	0x2c, 0xea, 0x90, 0x1b, 0xaf, 0xc3, 0x97, 0xe1, 0xc7, 0x66, 0x11, 0xd1, 0x3d, 0x47, 0xd8, 0xa0,
+	0x18, 0xfb, 0x64, 0xc7, 0x20, 0x47, 0x94, 0x7e, 0x9a, 0x90, 0xe8, 0x01, 0x0f, 0x5d, 0x71, 0xaf,
+	0xb5, 0x13, 0xef, 0xd5, 0xca, 0xd0, 0x28, 0x2c, 0x60, 0x61, 0xa4, 0x77, 0x98, 0x6e, 0xe4, 0xf2,
+	0x82, 0xf1, 0x38, 0x8f, 0x34, 0xe6, 0x1a, 0x3f, 0xae, 0xde, 0x69, 0x69, 0xc8, 0xbb, 0xef, 0x5c,
+	0x1e, 0x75, 0x25, 0x79, 0x08, 0x6f, 0xbd, 0x4e, 0x81, 0xcc, 0x06, 0x61, 0x97, 0x49, 0x60, 0xc2,
+	0x82, 0x06, 0x64, 0x8e, 0x8f, 0x58, 0x2b, 0x5d, 0xc1, 0x32, 0x0b, 0x8f, 0x81, 0x23, 0xaf, 0xd3,
+	0xf0, 0xfa, 0x1e, 0x53, 0x42, 0xf1, 0xa6, 0x98, 0x6e, 0x98, 0x4e, 0x0e, 0x80, 0x42, 0x44, 0xef,
+	0x91, 0xa5, 0x8e, 0xdf, 0xf7, 0x91, 0xea, 0xad, 0x28, 0xda, 0x43, 0x3a, 0x09, 0x8b, 0x08, 0xd0,
+	0xd0, 0x7e, 0x10, 0x55, 0xdd, 0x46, 0x1e, 0xc8, 0x96, 0x5a, 0x53, 0x0f, 0x30, 0xfa, 0x2e, 0xfd,
+	0x5d, 0x87, 0x50, 0x7b, 0x34, 0x11, 0xb7, 0x3b, 0xcf, 0x8f, 0xf6, 0xee, 0x14, 0xb7, 0xbb, 0x31,
+	0x82, 0xb4, 0x71, 0x91, 0xad, 0x90, 0x8e, 0x8e, 0xc3, 0x98, 0x05, 0x30, 0xa1, 0x3e, 0xc7, 0x9c,
+	0xbe, 0xb4, 0xd5, 0xee, 0xf9, 0x9d, 0x21, 0x63, 0x42, 0x54, 0x30, 0xec, 0xca, 0x17, 0x4e, 0x7c,
+	0xe5, 0xcf, 0x32, 0xa2, 0xe7, 0xb6, 0x46, 0x51, 0xc1, 0x38, 0xfc, 0xee, 0x6f, 0x96, 0x32, 0xce,
+	0xc5, 0x4e, 0xec, 0xfb, 0xb4, 0x4f, 0xaa, 0x61, 0xd4, 0xd1, 0x1a, 0xfb, 0x66, 0x01, 0x1a, 0x7b,
+	0x9b, 0xe1, 0x33, 0x19, 0x08, 0x7c, 0x4a, 0x40, 0x10, 0xa1, 0x5f, 0x70, 0xc8, 0x82, 0x4a, 0x57,
+	0x72, 0x80, 0xf4, 0xe1, 0x0a, 0x23, 0x7b, 0x41, 0x92, 0x5d, 0xb8, 0x67, 0x53, 0x81, 0x2c, 0x51,
+	0xf7, 0x07, 0xd9, 0x68, 0xe9, 0xbe, 0x97, 0xb6, 0x7b, 0xd7, 0x0f, 0xd0, 0x67, 0xbe, 0x93, 0xc9,
+	0x60, 0xfd, 0xb4, 0x9d, 0xc1, 0x62, 0xcc, 0xf6, 0x91, 0x49, 0xe5, 0xbb, 0x87, 0x88, 0x61, 0x85,
+	0xa3, 0xb0, 0x92, 0x5d, 0x9f, 0x23, 0x75, 0x6b, 0xc5, 0xd2, 0x38, 0x15, 0x95, 0xe2, 0xd1, 0x6e,
+	0x93, 0x35, 0x08, 0x36, 0x3d, 0xf7, 0xf7, 0x1c, 0x32, 0xdb, 0xf0, 0xda, 0x7b, 0xd1, 0xee, 0x2e,
+	0xfd, 0x28, 0xa9, 0x75, 0x86, 0x32, 0x75, 0x29, 0xf6, 0xa6, 0xb3, 0x52, 0x1b, 0x72, 0x1c, 0xf4,
+	0x0c, 0x4c, 0xb0, 0xed, 0x7a, 0x18, 0x03, 0xf3, 0x35, 0x97, 0x8d, 0x71, 0xba, 0xc1, 0x47, 0x41,
+	0x42, 0x31, 0x30, 0xd9, 0xf7, 0x1e, 0x29, 0x04, 0xf9, 0xa2, 0xcc, 0x5d, 0x03, 0x02, 0x7b, 0x9e,
+	0xfb, 0xb5, 0x0a, 0x99, 0x95, 0xe5, 0x8c, 0x63, 0xe7, 0xf2, 0x94, 0xeb, 0x5c, 0x9a, 0xe4, 0x3a,
+	0xb3, 0xe8, 0x70, 0xa6, 0xcd, 0x8b, 0xa3, 0xd2, 0x34, 0x4f, 0x13, 0xb4, 0xca, 0xd5, 0x89, 0x62,
+	0xab, 0x59, 0x93, 0x78, 0x06, 0x49, 0x07, 0xeb, 0x3d, 0x67, 0xda, 0x18, 0x13, 0xb5, 0x8d, 0xe5,
+	0xa8, 0x4c, 0x9d, 0x00, 0x5f, 0xcf, 0x62, 0x6c, 0x3c, 0x2b, 0xa9, 0x9f, 0xc9, 0x01, 0x20, 0x4f,
+	0x9b, 0x5e, 0x26, 0xd5, 0xa4, 0xe7, 0xc5, 0x1d, 0x66, 0xae, 0xf1, 0xd6, 0xe6, 0x50, 0xfc, 0x5a,
+	0x38, 0x00, 0x62, 0x1c, 0x73, 0x01, 0x3a, 0xd9, 0x99, 0xf0, 0xfa, 0x8f, 0xcc, 0x05, 0xe8, 0x6c,
+	0x68, 0x02, 0xd6, 0x0c, 0xda, 0x23, 0x95, 0x20, 0xdc, 0x8d, 0xa4, 0x39, 0xbc, 0x31, 0xfd, 0x81,
+	0x6e, 0x32, 0x6c, 0xe6, 0xf2, 0xf0, 0x09, 0x38, 0x05, 0xf7, 0x0f, 0x4b, 0xe4, 0xac, 0x3a, 0x74,
+	0x26, 0x59, 0x3e, 0x82, 0x30, 0x99, 0xa8, 0x5d, 0xbc, 0xf5, 0x68, 0x28, 0x43, 0xdf, 0xb2, 0x49,
+	0x26, 0x42, 0x06, 0x0a, 0xb9, 0xd9, 0x98, 0xff, 0xc5, 0x15, 0x89, 0x57, 0x05, 0x27, 0x6b, 0x37,
+	0x72, 0xad, 0xb9, 0x29, 0xdf, 0x32, 0x73, 0x98, 0x77, 0xb7, 0x84, 0x7a, 0x93, 0xaf, 0x00, 0x75,
+	0x26, 0xea, 0x58, 0xc9, 0x4d, 0x27, 0xd1, 0xca, 0xbc, 0x50, 0xb3, 0x95, 0x47, 0x04, 0xa3, 0xb8,
+	0x71, 0x85, 0xe8, 0x56, 0x5e, 0x8f, 0x63, 0x26, 0x6b, 0x95, 0x6c, 0x86, 0xba, 0xa5, 0x00, 0x60,
+	0xe6, 0xb8, 0x7f, 0x52, 0x26, 0x0b, 0x19, 0xe6, 0x44, 0xc9, 0x1e, 0x32, 0x19, 0xb1, 0xe2, 0x4a,
+	0x2d, 0xd9, 0x2f, 0xc9, 0x71, 0xd0, 0x33, 0x70, 0xf6, 0xc0, 0x4b, 0x92, 0x87, 0x11, 0xe3, 0x92,
+	0x52, 0x76, 0x76, 0x53, 0x8e, 0x83, 0x9e, 0x81, 0xf2, 0xfd, 0xc0, 0xf7, 0x62, 0x3f, 0xde, 0x89,
+	0xf6, 0xfc, 0x11, 0xf9, 0x6e, 0x18, 0x10, 0xd8, 0xf3, 0xb8, 0x5c, 0xa4, 0xfd, 0x64, 0xbd, 0x1f,
+	0x30, 0x7d, 0x28, 0x96, 0x59, 0x80, 0x5c, 0xec, 0x6c, 0xb5, 0x6c, 0x8c, 0x46, 0x2e, 0x72, 0x00,
+	0xc8, 0xd3, 0xa6, 0xbf, 0xc6, 0xac, 0x8e, 0xf7, 0x30, 0x31, 0xed, 0x13, 0x5c, 0x40, 0xa6, 0xd3,
+	0x10, 0x99, 0x76, 0x8c, 0xc6, 0x12, 0x9a, 0x9c, 0xcc, 0x10, 0x64, 0x29, 0xba, 0xbf, 0x5d, 0x22,
+	0x75, 0x4b, 0x08, 0xe8, 0x27, 0xc9, 0x82, 0xd0, 0x6c, 0x2f, 0xfb, 0x71, 0x62, 0xb4, 0xb2, 0xb6,
+	0x5f, 0x2d, 0x1b, 0x08, 0xd9, 0xb9, 0xf4, 0xb3, 0x64, 0xae, 0xad, 0xa4, 0x44, 0x9a, 0x95, 0x3b,
+	0x05, 0x68, 0x3b, 0x85, 0xd2, 0xf0, 0xa0, 0x1e, 0x02, 0x43, 0x90, 0xde, 0x24, 0x4b, 0x16, 0x1a,
+	0x29, 0x5e, 0x65, 0x2e, 0x5e, 0x3a, 0x2a, 0x5d, 0xcb, 0x4f, 0x80, 0xd1, 0x77, 0xdc, 0xbf, 0x72,
+	0xf4, 0x99, 0x9c, 0x42, 0xa9, 0xa5, 0x9b, 0x2d, 0xb5, 0x34, 0xa6, 0x3f, 0xb0, 0x09, 0x65, 0x96,
+	0x6d, 0x66, 0xdd, 0xa2, 0xfd, 0x7d, 0x2f, 0xec, 0xd0, 0x1f, 0x21, 0xb3, 0x6d, 0xf1, 0x53, 0x66,
+	0x5e, 0x79, 0x12, 0x5e, 0x42, 0x41, 0xc1, 0xe8, 0xfb, 0x49, 0x85, 0x11, 0x16, 0x2b, 0x9b, 0x13,
+	0x35, 0x8a, 0x35, 0xf6, 0x0c, 0x7c, 0xd4, 0xfd, 0x4a, 0x89, 0xb0, 0x00, 0x69, 0x7f, 0xc0, 0x04,
+	0xac, 0xb3, 0x13, 0xfd, 0xbf, 0x4f, 0x07, 0xb9, 0x6f, 0x32, 0xaf, 0x1e, 0xcf, 0x23, 0x0a, 0x99,
+	0x88, 0xeb, 0xd4, 0x23, 0xea, 0xd2, 0xb6, 0x1a, 0x95, 0xd2, 0x64, 0xf8, 0x58, 0x01, 0xc0, 0xcc,
+	0x39, 0x86, 0x4b, 0xf1, 0x21, 0x52, 0xe5, 0x99, 0x71, 0xa9, 0xf9, 0xf4, 0x75, 0xf3, 0xd4, 0x39,
+	0x08, 0x98, 0xfb, 0xd5, 0x12, 0xb9, 0x28, 0x84, 0xfc, 0xae, 0x17, 0x7a, 0x5d, 0x1f, 0x73, 0xaf,
+	0xc7, 0xcd, 0xf7, 0xd1, 0xcf, 0xa0, 0x85, 0x0d, 0x54, 0xd1, 0x60, 0x2a, 0x9e, 0x14, 0xbc, 0x24,
+	0xb8, 0x67, 0x93, 0xe1, 0x04, 0x8e, 0x99, 0xb9, 0x45, 0x35, 0xd5, 0x4d, 0x26, 0x4d, 0x59, 0x11,
+	0x54, 0xb4, 0xa0, 0xdd, 0x94, 0xb8, 0x41, 0x53, 0x71, 0xff, 0x8c, 0xa9, 0xff, 0x9c, 0xaf, 0xc2,
+	0xdd, 0x3c, 0x51, 0xb1, 0xcf, 0xbb, 0x79, 0xd9, 0x1a, 0xfb, 0xf1, 0xeb, 0xc3, 0x4c, 0x5b, 0xd4,
+	0xbd, 0x94, 0x09, 0xdc, 0x20, 0xe5, 0xf1, 0xf2, 0xc9, 0xcd, 0x34, 0xf7, 0x7c, 0xee, 0x46, 0x9d,
+	0x60, 0x37, 0xe0, 0xb1, 0xb2, 0x8d, 0xce, 0xfd, 0xeb, 0x32, 0x19, 0x13, 0xcd, 0x31, 0x8b, 0x58,
+	0x65, 0xe7, 0x90, 0xa8, 0x2b, 0xbd, 0xac, 0x38, 0xa2, 0x89, 0x83, 0xef, 0xda, 0x31, 0x3e, 0x1f,
+	0x01, 0x31, 0xfb, 0x24, 0xdb, 0x62, 0x16, 0x5a, 0x25, 0xdd, 0x24, 0xdb, 0xe9, 0xb3, 0xd6, 0x55,
+	0x53, 0x3d, 0x83, 0x1e, 0xd8, 0x49, 0xb7, 0x4a, 0x61, 0x49, 0x37, 0xf6, 0xff, 0x61, 0x3f, 0x15,
+	0x15, 0xbb, 0xb1, 0x09, 0xb7, 0x4f, 0x31, 0xc7, 0x25, 0xf5, 0x62, 0x71, 0xf4, 0xd5, 0x13, 0x1f,
+	0xbd, 0x71, 0x72, 0x14, 0x12, 0x30, 0xf8, 0xe8, 0x2b, 0x84, 0xec, 0x32, 0xde, 0x4d, 0x7a, 0x4f,
+	0x98, 0xf4, 0xe1, 0x17, 0x7b, 0x43, 0x63, 0x00, 0x0b, 0x9b, 0xeb, 0x91, 0x79, 0x3b, 0xef, 0xf6,
+	0x14, 0x18, 0xd3, 0x7d, 0x99, 0x2c, 0x64, 0x8a, 0x5e, 0xc7, 0x50, 0x03, 0x5a, 0xd1, 0x94, 0x1e,
+	0xa3, 0x68, 0xde, 0x2a, 0x91, 0x45, 0x5e, 0xba, 0xc6, 0xf6, 0xbc, 0x80, 0xa7, 0xe9, 0x3e, 0x40,
+	0xca, 0xc3, 0xb8, 0x2f, 0x11, 0xd7, 0xe5, 0x5b, 0x65, 0xac, 0xd9, 0xe3, 0xf8, 0x31, 0x34, 0x9c,
+	0xcb, 0x82, 0x26, 0x6f, 0x03, 0x0d, 0x2e, 0xf2, 0xda, 0xbc, 0x28, 0x68, 0xac, 0xaf, 0xe1, 0x08,
+	0x48, 0x08, 0x7d, 0x9e, 0xd4, 0xda, 0x7e, 0x9c, 0xf2, 0x59, 0x15, 0x3e, 0x6b, 0x1e, 0xb9, 0x71,
+	0x5d, 0x8e, 0x81, 0x86, 0xa2, 0xb9, 0xdb, 0xf3, 0x0f, 0xf9, 0xc4, 0x2a, 0x9f, 0x28, 0x6a, 0xce,
+	0x62, 0x08, 0x14, 0x2c, 0xe3, 0xb2, 0xce, 0x9c, 0xc8, 0x65, 0x9d, 0x3d, 0xca, 0x65, 0x75, 0x5f,
+	0x24, 0x35, 0x74, 0x52, 0xd0, 0x20, 0x17, 0x75, 0xee, 0x2d, 0x52, 0xbb, 0x7d, 0x7f, 0x47, 0xb8,
+	0xb6, 0x2e, 0x29, 0x07, 0x9e, 0x8a, 0x43, 0xf4, 0x3a, 0x36, 0x93, 0x64, 0xc8, 0x39, 0x0d, 0x81,
+	0x0c, 0x69, 0xd9, 0x7f, 0x34, 0xc8, 0x07, 0x1c, 0xd7, 0x1f, 0x0d, 0x02, 0x26, 0x3f, 0x38, 0x89,
+	0x41, 0xdd, 0x21, 0x21, 0xa6, 0x24, 0x58, 0xd0, 0x4a, 0x11, 0x4d, 0x3b, 0xea, 0x08, 0x3d, 0x5f,
+	0x33, 0x68, 0xd6, 0xd9, 0x18, 0x70, 0x88, 0xfb, 0x25, 0x87, 0x9c, 0xcd, 0xd7, 0xf1, 0xde, 0x33,
+	0xcb, 0xf9, 0x0a, 0x59, 0x1a, 0x29, 0xc0, 0x15, 0x75, 0x69, 0xef, 0x3a, 0xc4, 0xb4, 0x54, 0xd1,
+	0x5d, 0x59, 0x1f, 0x70, 0xa6, 0xf6, 0xfb, 0x31, 0x2a, 0x33, 0x6d, 0x53, 0xb5, 0x5c, 0x79, 0xe0,
+	0x0b, 0xcc, 0x4b, 0x42, 0xab, 0x1b, 0x60, 0xef, 0x76, 0xe3, 0x50, 0x9a, 0xf5, 0xbb, 0x45, 0xe4,
+	0x91, 0x37, 0x05, 0xda, 0x28, 0x36, 0x1e, 0xd2, 0xa6, 0xa1, 0x04, 0x36, 0x59, 0x37, 0x21, 0x74,
+	0xf4, 0xbd, 0x13, 0x46, 0x8a, 0x18, 0x3c, 0x0f, 0xd9, 0xdd, 0x20, 0x4a, 0xbe, 0x8f, 0x9a, 0x15,
+	0x3c, 0x2b, 0x00, 0x98, 0x39, 0xee, 0xdf, 0x54, 0x48, 0x2e, 0xc3, 0x4d, 0x87, 0x76, 0xc7, 0x9c,
+	0x53, 0x60, 0xc7, 0x9c, 0x5e, 0xc9, 0xd8, 0xae, 0x39, 0x6d, 0xa4, 0x4b, 0x4f, 0x6a, 0xa4, 0xcb,
+	0x47, 0x18, 0xe9, 0x37, 0x44, 0x99, 0x51, 0x98, 0x49, 0x19, 0xdb, 0x6e, 0x17, 0xc5, 0x55, 0xd2,
+	0xf8, 0xea, 0x7a, 0xa3, 0x78, 0x06, 0x8b, 0x62, 0xd6, 0xfc, 0xce, 0x3c, 0x55, 0xf3, 0x3b, 0x5b,
+	0xa4, 0xf9, 0xc5, 0x9a, 0x7f, 0xec, 0xa7, 0xf1, 0xa1, 0x08, 0x1a, 0x6b, 0x5c, 0x45, 0xea, 0xba,
+	0x05, 0x68, 0x08, 0x58, 0xb3, 0xdc, 0x37, 0xc8, 0xb9, 0x7c, 0xd7, 0x2b, 0x33, 0x29, 0xa8, 0x06,
+	0xba, 0xd8, 0x94, 0x2c, 0x79, 0x59, 0xab, 0x01, 0xde, 0xa9, 0x0c, 0x02, 0x86, 0xda, 0x64, 0x2f,
+	0x08, 0x3b, 0x79, 0x4d, 0x85, 0x8d, 0xcc, 0xc0, 0x21, 0x5a, 0xdf, 0x94, 0x27, 0xd6, 0xe4, 0xff,
+	0xd4, 0x21, 0x57, 0x8e, 0x6a, 0xce, 0xc5, 0x10, 0xee, 0xa1, 0x17, 0x87, 0xb2, 0x51, 0x88, 0xeb,
+	0x85, 0xfb, 0xec, 0x19, 0xf8, 0x28, 0x36, 0x38, 0x89, 0xa2, 0xac, 0x0c, 0x3e, 0xb7, 0x0b, 0xec,
+	0x13, 0x66, 0x67, 0x61, 0xbc, 0x16, 0x51, 0x0d, 0x06, 0x49, 0xcd, 0xfd, 0x1f, 0xa6, 0xee, 0xf3,
+	0x1d, 0x9d, 0xf4, 0x12, 0x29, 0x05, 0x1d, 0x79, 0x6a, 0x44, 0xbe, 0x58, 0xda, 0xdc, 0x00, 0x36,
+	0x9a, 0x95, 0xd8, 0xd2, 0xa9, 0x49, 0xec, 0x2f, 0x90, 0xda, 0x6b, 0x43, 0x7f, 0xf8, 0x84, 0x8e,
+	0xbc, 0x56, 0x63, 0x2f, 0x4a, 0x1c, 0xa0, 0xb1, 0xb9, 0xdf, 0x2a, 0x91, 0xba, 0xd5, 0xb9, 0x7f,
+	0x0c, 0xf3, 0x92, 0xfb, 0xd2, 0xa0, 0x74, 0xcc, 0x2f, 0x0d, 0x98, 0x97, 0x34, 0xc0, 0x66, 0x80,
+	0x40, 0x37, 0x87, 0x70, 0x2f, 0xa9, 0x29, 0xc7, 0x40, 0x43, 0x59, 0x00, 0x3f, 0xf7, 0xea, 0xc3,
+	0x94, 0xfb, 0x13, 0xca, 0x67, 0x5f, 0x9f, 0xa6, 0xb3, 0x48, 0xfa, 0x26, 0xe6, 0x88, 0xd5, 0x08,
+	0xf3, 0xd8, 0x35, 0x21, 0xf4, 0xf4, 0xb8, 0x48, 0x88, 0x62, 0xae, 0x6c, 0x5d, 0xe1, 0xb2, 0xc2,
+	0x9c, 0x5c, 0x01, 0x71, 0xff, 0xb8, 0x4c, 0x88, 0xe5, 0x5d, 0xb2, 0xb3, 0xc2, 0xce, 0xcf, 0xfc,
+	0x59, 0xe1, 0x0c, 0xe0, 0x90, 0x8c, 0x49, 0x29, 0x9d, 0xc8, 0x93, 0x2b, 0x1f, 0x99, 0x7c, 0xc4,
+	0x0c, 0x59, 0xd2, 0x6b, 0xc6, 0xc1, 0x01, 0x33, 0x25, 0x8c, 0xc9, 0x65, 0x7e, 0xd4, 0x64, 0xc8,
+	0x5a, 0xb7, 0x0c, 0x10, 0xb2, 0x73, 0xc7, 0xa6, 0xe6, 0xab, 0xef, 0x61, 0x6a, 0xbe, 0x45, 0x2e,
+	0x04, 0x61, 0x82, 0xcd, 0x82, 0xb2, 0x97, 0xe3, 0x56, 0x94, 0xa4, 0xb8, 0xa9, 0x19, 0xae, 0x2f,
+	0x3e, 0x20, 0x11, 0x5d, 0xd8, 0x1c, 0x37, 0x09, 0xc6, 0xbf, 0xcb, 0x3f, 0x62, 0x32, 0xd7, 0xf5,
+	0x7f, 0xeb, 0x23, 0x26, 0xb3, 0xee, 0x09, 0x59, 0xb4, 0x6f, 0x97, 0xc8, 0xbc, 0x52, 0x71, 0xd8,
+	0xca, 0x52, 0x94, 0xbe, 0xcf, 0x34, 0x85, 0x97, 0x8f, 0x6e, 0x0a, 0xd7, 0x1a, 0xa3, 0xf2, 0x38,
+	0x8d, 0x21, 0xda, 0x98, 0x0d, 0x9f, 0x59, 0x1a, 0x63, 0xc7, 0x80, 0xc0, 0x9e, 0x87, 0x2b, 0xe9,
+	0x07, 0x07, 0xbe, 0x78, 0x69, 0x26, 0xbb, 0x92, 0x2d, 0x05, 0x00, 0x33, 0x07, 0x57, 0xd2, 0x61,
+	0x27, 0x21, 0xa3, 0x20, 0xbd, 0x12, 0x3c, 0x1d, 0xe0, 0x10, 0xf7, 0xdf, 0x1c, 0xf2, 0xbe, 0x89,
+	0x3d, 0x43, 0xa7, 0x66, 0x31, 0xb3, 0x67, 0x5c, 0x39, 0xc6, 0x19, 0x7f, 0x9c, 0xcc, 0x63, 0x27,
+	0x6b, 0x33, 0x0a, 0x42, 0xde, 0xac, 0x28, 0x54, 0xd4, 0x59, 0x6c, 0xdc, 0xb8, 0xdd, 0xba, 0xb7,
+	0xad, 0xc6, 0x21, 0x33, 0xcb, 0xfd, 0x52, 0x95, 0x5c, 0xd4, 0xd5, 0x5f, 0x3f, 0x65, 0x5a, 0x83,
+	0xad, 0xaf, 0xcb, 0x73, 0xd4, 0xdf, 0x70, 0xc8, 0xbc, 0x38, 0xeb, 0x2d, 0xef, 0x81, 0xdf, 0x57,
+	0xe5, 0xed, 0x76, 0x11, 0x75, 0xe6, 0x0c, 0xa5, 0x95, 0x1d, 0x8b, 0xca, 0xf5, 0x90, 0x39, 0x2d,
+	0xa6, 0xe7, 0xc4, 0x06, 0x41, 0x66, 0x39, 0xf4, 0x11, 0x99, 0x53, 0x9d, 0xef, 0xbb, 0x05, 0xf4,
+	0xfe, 0x9b, 0xbc, 0xcd, 0xae, 0x71, 0xa7, 0x54, 0xab, 0xfd, 0x2e, 0xb3, 0x03, 0x9a, 0x18, 0x76,
+	0xbb, 0xcc, 0xf4, 0xc5, 0x99, 0x94, 0x39, 0xdd, 0x5f, 0x2a, 0xfe, 0x4c, 0xec, 0xd3, 0xd0, 0xae,
+	0x89, 0x3c, 0x07, 0x49, 0xdc, 0x6e, 0x20, 0xa9, 0x14, 0xd4, 0x40, 0x72, 0xe9, 0xe7, 0xc9, 0xd2,
+	0xc8, 0x75, 0xd0, 0xb3, 0xa4, 0xbc, 0xc7, 0x14, 0x2d, 0xe7, 0x79, 0xc0, 0x9f, 0xf4, 0x7c, 0x26,
+	0x80, 0x94, 0x11, 0xe3, 0xcf, 0x94, 0xae, 0x39, 0x97, 0x3e, 0x41, 0xea, 0x4f, 0xf8, 0xaa, 0xfb,
+	0x2f, 0x15, 0xa3, 0xaf, 0xb0, 0xcb, 0x00, 0xab, 0xff, 0xb1, 0xb9, 0x16, 0xa9, 0x8d, 0x8b, 0xba,
+	0x64, 0xad, 0x5d, 0xac, 0x41, 0xb0, 0xe9, 0xd1, 0xd7, 0x79, 0xf7, 0x2f, 0x06, 0xee, 0x8c, 0x01,
+	0x9e, 0x16, 0x8b, 0x35, 0x35, 0x05, 0xb0, 0xa8, 0x51, 0x5f, 0xd6, 0x8d, 0xcb, 0x53, 0x3b, 0x37,
+	0x2a, 0x97, 0x33, 0xae, 0x68, 0x8c, 0x46, 0x7e, 0x31, 0xcc, 0x70, 0x9e, 0x0c, 0xc5, 0x5e, 0x2c,
+	0x9c, 0xa5, 0x45, 0x03, 0x57, 0x76, 0x0c, 0x72, 0xc4, 0xe9, 0x1a, 0x39, 0xa3, 0x6e, 0x40, 0x55,
+	0xf5, 0x84, 0x2d, 0xd0, 0x7e, 0x02, 0x64, 0xc1, 0x90, 0x9f, 0x6f, 0x35, 0x18, 0xcf, 0x4c, 0x6c,
+	0x30, 0x7e, 0x93, 0x39, 0xf5, 0x0a, 0xd1, 0xbd, 0x03, 0x3f, 0x8e, 0x83, 0x0e, 0x57, 0xb9, 0xa2,
+	0x63, 0x70, 0x6b, 0xe8, 0xe5, 0x73, 0x38, 0xb7, 0x14, 0x00, 0xcc, 0x1c, 0xac, 0xe2, 0x8d, 0x76,
+	0xac, 0x0a, 0xa5, 0x7f, 0xa2, 0xde, 0x52, 0x6c, 0x5d, 0xb7, 0xb9, 0xf0, 0x78, 0x56, 0x86, 0xc5,
+	0xda, 0x07, 0xf2, 0x88, 0x72, 0xe9, 0x54, 0x75, 0x34, 0x0a, 0xae, 0x0d, 0x52, 0xf9, 0x78, 0x26,
+	0xbd, 0x72, 0x02, 0x93, 0x5e, 0x9d, 0x18, 0xf3, 0xbd, 0x59, 0x21, 0x8b, 0xd9, 0x64, 0xf8, 0x0f,
+	0xc5, 0xbe, 0x98, 0xa1, 0x55, 0xe9, 0x6e, 0xe1, 0x70, 0xbc, 0x3f, 0x9b, 0xee, 0x7e, 0x97, 0x47,
+	0xe1, 0xb8, 0x5d, 0x9e, 0x33, 0x1c, 0x93, 0xfc, 0x9e, 0x3d, 0x22, 0x33, 0x72, 0x8d, 0xd4, 0x7a,
+	0xb2, 0xff, 0x8e, 0x87, 0xf7, 0x86, 0x84, 0xee, 0xd4, 0xcb, 0x74, 0xed, 0xe9, 0xd9, 0x4c, 0x7a,
+	0xe6, 0xf0, 0x37, 0x4f, 0xc9, 0xc8, 0xee, 0xc4, 0x0f, 0x69, 0x0e, 0x56, 0x80, 0x31, 0xd9, 0x1b,
+	0xf3, 0x16, 0x4f, 0x87, 0xb3, 0xd0, 0x96, 0xe4, 0xd2, 0xe1, 0x2c, 0xb6, 0xc5, 0x71, 0xb6, 0xb6,
+	0x79, 0x55, 0xa4, 0xe2, 0x7f, 0x35, 0xa1, 0xce, 0xe7, 0x69, 0x73, 0x7d, 0xd3, 0x82, 0x41, 0x66,
+	0xa6, 0xfb, 0x56, 0xd9, 0xb0, 0x83, 0x2c, 0x1c, 0xfc, 0x50, 0xb0, 0xc3, 0xb5, 0x1c, 0x3b, 0x5c,
+	0x19, 0x61, 0x87, 0x45, 0xd3, 0x10, 0x9d, 0x61, 0x09, 0xd3, 0x08, 0x3d, 0x7b, 0x3a, 0x8d, 0xd0,
+	0x6c, 0x33, 0x78, 0xd1, 0xf2, 0x83, 0x6d, 0xbd, 0x19, 0xe4, 0x0c, 0xe0, 0x10, 0xf7, 0x0f, 0x1c,
+	0xb2, 0xc0, 0x53, 0x48, 0xad, 0x14, 0x2f, 0xae, 0xcb, 0x53, 0x44, 0x7d, 0xde, 0xe7, 0x2e, 0xf2,
+	0xf5, 0xfa, 0x8e, 0x44, 0x73, 0xbb, 0x80, 0xd1, 0x80, 0xcc, 0x3e, 0x10, 0x5d, 0x72, 0x05, 0x54,
+	0x61, 0x65, 0xbf, 0x9d, 0x28, 0x7c, 0xc8, 0x07, 0x50, 0xf8, 0xdd, 0xdf, 0xaf, 0x92, 0x33, 0xb9,
+	0x1e, 0xec, 0x4c, 0xbd, 0xaf, 0x74, 0x64, 0xbd, 0xef, 0xd3, 0x84, 0x74, 0xfc, 0x41, 0x3f, 0x3a,
+	0xe4, 0xa9, 0x92, 0xca, 0x93, 0xf7, 0x08, 0x6f, 0x68, 0x2c, 0x60, 0x61, 0x94, 0xb9, 0x21, 0xd1,
+	0x3f, 0x96, 0xcf, 0x0d, 0x99, 0xc6, 0x83, 0x99, 0x53, 0x6c, 0x3c, 0x08, 0xc8, 0x19, 0xb1, 0x3e,
+	0x9d, 0xab, 0x7c, 0x82, 0x94, 0xe4, 0x39, 0x34, 0xbe, 0x1b, 0x59, 0x34, 0x90, 0xc7, 0x3b, 0x92,
+	0xbd, 0xaf, 0xbd, 0x27, 0xd9, 0xfb, 0x6c, 0x4d, 0x77, 0xee, 0xd4, 0x6a, 0xba, 0xee, 0xcf, 0x92,
+	0x33, 0x28, 0xec, 0x42, 0xee, 0xd6, 0x7b, 0x7e, 0x7b, 0x0f, 0xf5, 0x17, 0xfe, 0x59, 0x9a, 0x68,
+	0x98, 0xe6, 0xbf, 0x0d, 0xde, 0x11, 0xc3, 0xa0, 0xe0, 0xee, 0xd7, 0x67, 0xc8, 0x42, 0x26, 0x8d,
+	0x9d, 0xe1, 0x6c, 0xe7, 0x48, 0xce, 0x66, 0xb2, 0x3a, 0x88, 0x87, 0xa1, 0x2f, 0x6b, 0x0d, 0x5a,
+	0x56, 0x9b, 0x38, 0x08, 0x02, 0x86, 0xd5, 0xda, 0x4e, 0x7c, 0x08, 0xc3, 0x50, 0x96, 0xb8, 0x34,
+	0xd3, 0x6c, 0xf0, 0x51, 0x90, 0x50, 0xe6, 0x7b, 0xcf, 0x27, 0x5c, 0x6f, 0x09, 0x45, 0x20, 0x05,
+	0xe5, 0xe6, 0xd4, 0xdf, 0x85, 0x08, 0x74, 0x22, 0x30, 0xb5, 0x47, 0x20, 0x43, 0x0e, 0x1b, 0xce,
+	0xac, 0x2b, 0x14, 0x7f, 0x67, 0xa3, 0x59, 0x60, 0x79, 0x40, 0x48, 0xcc, 0xe3, 0x3f, 0x89, 0x19,
+	0x68, 0x69, 0x9d, 0x7d, 0x0a, 0xd2, 0x4a, 0xc6, 0x4a, 0x6a, 0x95, 0x67, 0xed, 0xa5, 0xdc, 0xdc,
+	0x9a, 0x8a, 0x67, 0x2d, 0x35, 0x2e, 0x1a, 0x59, 0xf9, 0x10, 0x08, 0x0a, 0x18, 0x5b, 0xf5, 0x0c,
+	0x9b, 0xca, 0xaf, 0xa6, 0x6f, 0x4f, 0x79, 0xc2, 0x16, 0xe3, 0x8b, 0x3f, 0x24, 0x62, 0x0d, 0x80,
+	0x4d, 0xcf, 0x16, 0x0b, 0x72, 0x84, 0x58, 0x7c, 0xde, 0x21, 0x17, 0xc6, 0x5e, 0xdf, 0xe9, 0xd5,
+	0x2f, 0xfe, 0xa8, 0x4c, 0xce, 0x8d, 0x29, 0x30, 0x65, 0xf5, 0x8c, 0x73, 0x7a, 0xbd, 0x23, 0x27,
+	0xb3, 0x78, 0xc6, 0xea, 0x94, 0x4f, 0xd1, 0xea, 0x3c, 0x22, 0xe7, 0xad, 0x0b, 0x37, 0xa6, 0xe7,
+	0xe4, 0x16, 0x97, 0x7f, 0x5c, 0x77, 0x6b, 0x0c, 0x2e, 0x18, 0x4b, 0xc1, 0xfd, 0x66, 0x85, 0x58,
+	0x5f, 0x19, 0xd2, 0x5f, 0xb1, 0xcb, 0xb0, 0x4e, 0x21, 0x85, 0x46, 0x81, 0x59, 0xd7, 0x70, 0xc5,
+	0x4d, 0x8d, 0x2b, 0xe9, 0x1a, 0x89, 0x2e, 0x9d, 0xb6, 0x44, 0x97, 0x4f, 0x59, 0xa2, 0x5f, 0x23,
+	0xb5, 0x44, 0x7e, 0x2d, 0x53, 0x94, 0xb1, 0x90, 0xe8, 0x44, 0x19, 0x48, 0x3d, 0x81, 0x26, 0x83,
+	0xd1, 0xc8, 0x6e, 0xe0, 0xf7, 0x3b, 0xa2, 0x6b, 0x30, 0x96, 0x5e, 0xba, 0x8e, 0x46, 0x6e, 0x58,
+	0x30, 0xc8, 0xcc, 0x74, 0xff, 0xd9, 0x11, 0x02, 0x9d, 0xbb, 0x48, 0x63, 0x42, 0x9d, 0xc7, 0x98,
+	0x50, 0x26, 0x7d, 0x89, 0xdf, 0xdf, 0xc5, 0x93, 0x90, 0xa6, 0x56, 0x4b, 0x5f, 0x4b, 0x8e, 0x83,
+	0x9e, 0x81, 0xf5, 0x5a, 0xfe, 0x9a, 0xf8, 0x5c, 0xb4, 0x9c, 0xad, 0xd7, 0x36, 0x35, 0x04, 0xac,
+	0x59, 0x98, 0x59, 0xe0, 0x4f, 0x4d, 0x9f, 0x49, 0x52, 0x98, 0x8a, 0x57, 0x2b, 0xd9, 0xfe, 0xe0,
+	0x66, 0x7e, 0x02, 0x8c, 0xbe, 0x83, 0x89, 0x8e, 0x79, 0xfb, 0x28, 0x79, 0x7f, 0x4b, 0xac, 0xbd,
+	0x09, 0xd3, 0xdf, 0xc2, 0xc6, 0x80, 0x43, 0x70, 0x77, 0xa8, 0x79, 0x5f, 0x89, 0xc2, 0x91, 0x82,
+	0xd4, 0x8e, 0x1c, 0x07, 0x3d, 0x23, 0xf3, 0x55, 0x4c, 0xf9, 0xa8, 0xaf, 0x62, 0xdc, 0x7f, 0x77,
+	0x84, 0x64, 0xca, 0x00, 0xf0, 0x5a, 0xae, 0x73, 0xec, 0xf8, 0xb1, 0xd3, 0x21, 0x7e, 0x5b, 0xa9,
+	0xfa, 0x79, 0x0b, 0xf8, 0x66, 0xd5, 0x34, 0x07, 0xdb, 0x5f, 0x54, 0xaa, 0x31, 0xb0, 0x88, 0x9d,
+	0xac, 0xbb, 0xd0, 0xfd, 0x57, 0x75, 0x01, 0xca, 0xb1, 0xd9, 0x27, 0x55, 0x5c, 0xc1, 0x61, 0x01,
+	0xad, 0xc7, 0x36, 0x5e, 0xd4, 0xcb, 0x52, 0x29, 0xf0, 0x9f, 0x20, 0xa8, 0x30, 0xfd, 0x23, 0x62,
+	0xbe, 0xe9, 0x5b, 0xdc, 0x6d, 0x6a, 0x18, 0x32, 0xca, 0x3f, 0x04, 0x63, 0x82, 0xc7, 0xef, 0x38,
+	0x64, 0x69, 0x64, 0x49, 0x28, 0x51, 0xbb, 0x91, 0x6a, 0xb5, 0xb6, 0x24, 0xea, 0x06, 0x0e, 0x82,
+	0x80, 0x61, 0xda, 0x4f, 0xb4, 0xe7, 0xb7, 0x82, 0x8e, 0xcf, 0xdf, 0x93, 0x82, 0xa5, 0xd3, 0x7e,
+	0xad, 0x2c, 0x18, 0xf2, 0xf3, 0x47, 0x74, 0x41, 0xf9, 0xd8, 0xba, 0xe0, 0x5b, 0x0e, 0x39, 0x9b,
+	0xdf, 0x1c, 0x7e, 0xf7, 0xb8, 0x94, 0xe4, 0x37, 0xf3, 0x54, 0xee, 0x4c, 0x0b, 0xf4, 0x08, 0x08,
+	0x46, 0x57, 0xe0, 0xfe, 0x57, 0x49, 0x48, 0x90, 0xf8, 0x83, 0x6c, 0xda, 0xb9, 0x71, 0x26, 0x3a,
+	0x37, 0x1f, 0xb5, 0xd4, 0x72, 0x4e, 0x9c, 0xc7, 0x68, 0xd4, 0x13, 0x89, 0x33, 0xd6, 0x9c, 0xec,
+	0x4f, 0x12, 0x78, 0x15, 0x42, 0xd6, 0x9c, 0xec, 0xaf, 0x17, 0x20, 0x33, 0x2b, 0xf7, 0x09, 0x55,
+	0xf5, 0xc8, 0x4f, 0xa8, 0xb0, 0x79, 0x52, 0x7c, 0x2d, 0xa0, 0x52, 0xba, 0xa2, 0x79, 0x52, 0x8e,
+	0x81, 0x86, 0xa2, 0xaa, 0xdd, 0xf7, 0xc2, 0xa1, 0xd7, 0xc7, 0x13, 0xe2, 0x4e, 0x7b, 0xcd, 0x88,
+	0xf3, 0x5d, 0x0d, 0x01, 0x6b, 0x56, 0x46, 0xdd, 0xd5, 0x8e, 0x52, 0x77, 0x28, 0xce, 0xf9, 0x8f,
+	0x65, 0x10, 0x83, 0x2a, 0x2e, 0x4b, 0x26, 0x37, 0x5d, 0x8d, 0x72, 0x1c, 0xf4, 0x0c, 0x5c, 0xa3,
+	0x60, 0xdd, 0x6d, 0x53, 0xf1, 0xd7, 0x6b, 0x6c, 0x69, 0x08, 0x58, 0xb3, 0x32, 0xed, 0xa3, 0xe5,
+	0xe3, 0xb6, 0x8f, 0x56, 0x1e, 0xd3, 0x3e, 0x6a, 0x7a, 0x56, 0xab, 0x93, 0x7a, 0x56, 0x1b, 0x2b,
+	0x6f, 0xff, 0xd3, 0x73, 0xcf, 0x7c, 0x97, 0xfd, 0xfb, 0x1e, 0xfb, 0xf7, 0xf9, 0xef, 0x3f, 0xe7,
+	0xbc, 0xcd, 0xfe, 0x7d, 0x97, 0xfd, 0xfb, 0x1e, 0xfb, 0xf7, 0x8f, 0xec, 0xdf, 0xd7, 0x7e, 0xf0,
+	0xdc, 0x33, 0xaf, 0xd4, 0x14, 0x67, 0xff, 0x2f, 0x6b, 0x30, 0xb6, 0xda, 0xd9, 0x56, 0x00, 0x00,
 }
@@ -6,7 +6,9 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,6 +20,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/cron"
 	"github.com/argoproj/argo-cd/util/git"
 )
 
@@ -31,6 +34,8 @@ type Application struct {
 	Spec              ApplicationSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
 	Status            ApplicationStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
 	Operation         *Operation        `json:"operation,omitempty" protobuf:"bytes,4,opt,name=operation"`
+	// PendingOperations is a FIFO queue of operations which are started one at a time, once the current operation completed
+	PendingOperations []PendingOperation `json:"pendingOperations,omitempty" protobuf:"bytes,5,opt,name=pendingOperations"`
 }
 
 // ApplicationSpec represents desired application state. Contains link to repository with application definition and additional parameters link definition revision.
@@ -45,6 +50,9 @@ type ApplicationSpec struct {
 	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,4,name=syncPolicy"`
 	// IgnoreDifferences controls resources fields which should be ignored during comparison
 	IgnoreDifferences []ResourceIgnoreDifferences `json:"ignoreDifferences,omitempty" protobuf:"bytes,5,name=ignoreDifferences"`
+	// RevisionHistoryLimit is the maximum number of deployments kept in the application's history.
+	// Defaults to 10, zero keeps no history.
+	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,6,opt,name=revisionHistoryLimit"`
 }
 
 // ResourceIgnoreDifferences contains resource filter and list of json paths which should be ignored during comparison with live state.
@@ -224,11 +232,45 @@ type ApplicationStatus struct {
 	ObservedAt     metav1.Time              `json:"observedAt,omitempty" protobuf:"bytes,8,opt,name=observedAt"`
 	SourceType     ApplicationSourceType    `json:"sourceType,omitempty" protobuf:"bytes,9,opt,name=sourceType"`
 	Ingress        []v1.LoadBalancerIngress `json:"ingress,omitempty" protobuf:"bytes,10,opt,name=ingress"`
+	// DeletionHookTypes are the deletion hook types found in the manifests when the application was last compared
+	DeletionHookTypes []HookType `json:"deletionHookTypes,omitempty" protobuf:"bytes,11,rep,name=deletionHookTypes,casttype=HookType"`
+	// DeletionHooksState is the state of the deletion hooks run during the cascading deletion of the application
+	DeletionHooksState *DeletionHooksState `json:"deletionHooksState,omitempty" protobuf:"bytes,12,opt,name=deletionHooksState"`
+	// LastScheduledSyncAt is the time the last automated sync was started by the sync schedule
+	LastScheduledSyncAt *metav1.Time `json:"lastScheduledSyncAt,omitempty" protobuf:"bytes,13,opt,name=lastScheduledSyncAt"`
 }
 
 // Operation contains requested operation parameters.
 type Operation struct {
 	Sync *SyncOperation `json:"sync,omitempty" protobuf:"bytes,1,opt,name=sync"`
+	// InitiatedBy contains information about who initiated the operation
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,2,opt,name=initiatedBy"`
+}
+
+// PendingOperation is an operation waiting in the queue of an application
+type PendingOperation struct {
+	// ID identifies the operation within the queue
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// Operation is the queued operation
+	Operation Operation `json:"operation" protobuf:"bytes,2,opt,name=operation"`
+	// QueuedAt is the time the operation was added to the queue
+	QueuedAt metav1.Time `json:"queuedAt" protobuf:"bytes,3,opt,name=queuedAt"`
+}
+
+// OperationInitiator holds information about the operation initiator
+type OperationInitiator struct {
+	// Username is the name of the user who started the operation
+	Username string `json:"username,omitempty" protobuf:"bytes,1,opt,name=username"`
+	// Automated is set to true if the operation was started automatically by the application controller
+	Automated bool `json:"automated,omitempty" protobuf:"bytes,2,opt,name=automated"`
+}
+
+// String returns the user name, or "automated" if the operation was started by the controller
+func (i OperationInitiator) String() string {
+	if i.Automated {
+		return "automated"
+	}
+	return i.Username
 }
 
 // SyncOperationResource contains resources to sync.
@@ -262,6 +304,26 @@ type SyncOperation struct {
 	// Source overrides the source definition set in the application.
 	// This is typically set in a Rollback operation and nil during a Sync operation
 	Source *ApplicationSource `json:"source,omitempty" protobuf:"bytes,7,opt,name=source"`
+	// Retry controls failed sync retry behavior
+	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,8,opt,name=retry"`
+	// HealthCheck keeps the operation running until all synced resources are healthy
+	HealthCheck *SyncHealthCheck `json:"healthCheck,omitempty" protobuf:"bytes,9,opt,name=healthCheck"`
+	// Timeout is the maximum amount of time the operation may run, after which it is terminated. Default unit is
+	// seconds, but could also be a duration (e.g. "2m", "1h"). Overrides the default operation timeout configured in argocd-cm
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,10,opt,name=timeout"`
+}
+
+// GetTimeout returns the operation timeout, or the given default timeout if none is specified. A zero duration
+// means the operation never times out
+func (o *SyncOperation) GetTimeout(defaultTimeout time.Duration) (time.Duration, error) {
+	if o.Timeout == "" {
+		return defaultTimeout, nil
+	}
+	timeout, err := parseStringToDuration(o.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid operation timeout: %v", err)
+	}
+	return timeout, nil
 }
 
 type OperationPhase string
@@ -300,18 +362,248 @@ type OperationState struct {
 	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,6,opt,name=startedAt"`
 	// FinishedAt contains time of operation completion
 	FinishedAt *metav1.Time `json:"finishedAt" protobuf:"bytes,7,opt,name=finishedAt"`
+	// RetryCount contains the number of times the operation was retried
+	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+}
+
+// DeletionHooksState contains information about the deletion hooks of an application which is being deleted
+type DeletionHooksState struct {
+	// Phase is the current phase of the deletion hooks
+	Phase OperationPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Message holds any pertinent messages when attempting to run the deletion hooks (typically errors).
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// Revision holds the git commit SHA the deletion hooks were taken from
+	Revision string `json:"revision,omitempty" protobuf:"bytes,3,opt,name=revision"`
+	// Resources holds the status of each individual deletion hook
+	Resources ResourceResults `json:"resources,omitempty" protobuf:"bytes,4,rep,name=resources"`
+	// StartedAt contains time the deletion hooks were started
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,5,opt,name=startedAt"`
+	// FinishedAt contains time the deletion hooks were completed
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,6,opt,name=finishedAt"`
 }
 
 // SyncPolicy controls when a sync will be performed in response to updates in git
 type SyncPolicy struct {
 	// Automated will keep an application synced to the target revision
 	Automated *SyncPolicyAutomated `json:"automated,omitempty" protobuf:"bytes,1,opt,name=automated"`
+	// Retry controls failed sync retry behavior
+	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,2,opt,name=retry"`
+	// HealthCheck keeps sync operations running until all synced resources are healthy
+	HealthCheck *SyncHealthCheck `json:"healthCheck,omitempty" protobuf:"bytes,3,opt,name=healthCheck"`
+	// Schedule restricts automated syncs to the times of a cron schedule
+	Schedule *SyncSchedule `json:"schedule,omitempty" protobuf:"bytes,4,opt,name=schedule"`
+	// FieldManager is the field manager of the resources synced with server-side apply, which is used
+	// by sync operations without a field manager and to diff the fields owned by Argo CD.
+	// Defaults to argocd-controller
+	FieldManager string `json:"fieldManager,omitempty" protobuf:"bytes,5,opt,name=fieldManager"`
+}
+
+// SyncHealthCheck controls waiting for the synced resources to become healthy before a sync
+// operation is considered successful
+type SyncHealthCheck struct {
+	// Timeout is the maximum amount of time to wait for the synced resources to become healthy, after
+	// which the operation fails. Default unit is seconds, but could also be a duration (e.g. "2m", "1h")
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,1,opt,name=timeout"`
+}
+
+// DefaultSyncHealthCheckTimeout is the default amount of time to wait for synced resources to become healthy
+const DefaultSyncHealthCheckTimeout = 10 * time.Minute
+
+// GetTimeout returns the health check timeout, or the default timeout if none is specified
+func (h *SyncHealthCheck) GetTimeout() (time.Duration, error) {
+	if h.Timeout == "" {
+		return DefaultSyncHealthCheckTimeout, nil
+	}
+	timeout, err := parseStringToDuration(h.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid health check timeout: %v", err)
+	}
+	return timeout, nil
+}
+
+// SyncSchedule restricts automated syncs of an OutOfSync application to the times of a cron schedule
+type SyncSchedule struct {
+	// Cron is the schedule of the automated syncs, specified in cron format (e.g. "0 2 * * *")
+	Cron string `json:"cron" protobuf:"bytes,1,opt,name=cron"`
+	// TimeZone is the IANA time zone the schedule is evaluated in (e.g. "Europe/Berlin"). Defaults to UTC
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,2,opt,name=timeZone"`
+	// Duration is the amount of time after each scheduled time during which an automated sync may start (e.g. 30m)
+	Duration string `json:"duration,omitempty" protobuf:"bytes,3,opt,name=duration"`
+}
+
+// DefaultSyncScheduleDuration is the default amount of time after a scheduled time during which an automated sync may start
+const DefaultSyncScheduleDuration = time.Hour
+
+func (s *SyncSchedule) parse() (*cron.Schedule, *time.Location, time.Duration, error) {
+	schedule, err := cron.Parse(s.Cron)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("schedule is invalid: %v", err)
+	}
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("time zone '%s' is invalid: %v", s.TimeZone, err)
+	}
+	duration := DefaultSyncScheduleDuration
+	if s.Duration != "" {
+		duration, err = time.ParseDuration(s.Duration)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("duration '%s' is invalid: %v", s.Duration, err)
+		}
+		if duration <= 0 {
+			return nil, nil, 0, fmt.Errorf("duration '%s' is invalid: must be positive", s.Duration)
+		}
+	}
+	return schedule, loc, duration, nil
+}
+
+// Validate checks the cron expression, time zone and duration of the schedule
+func (s *SyncSchedule) Validate() error {
+	_, _, _, err := s.parse()
+	return err
+}
+
+// Due returns whether an automated sync is due at the given time, i.e. the schedule was activated at most the schedule
+// duration ago, and no sync was attempted since then
+func (s *SyncSchedule) Due(now time.Time, lastAttempt *metav1.Time) (bool, error) {
+	schedule, loc, duration, err := s.parse()
+	if err != nil {
+		return false, err
+	}
+	prev := schedule.Prev(now.In(loc), duration)
+	if prev.IsZero() {
+		return false, nil
+	}
+	return lastAttempt == nil || lastAttempt.Time.Before(prev), nil
+}
+
+// NextRun returns the first scheduled time strictly after the given time, in the time zone of the schedule
+func (s *SyncSchedule) NextRun(now time.Time) (time.Time, error) {
+	schedule, loc, _, err := s.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(now.In(loc)), nil
+}
+
+// RetryStrategy contains information about the strategy to apply when a sync failed
+type RetryStrategy struct {
+	// Limit is the maximum number of attempts when retrying a sync. A negative value means unlimited retries.
+	Limit int64 `json:"limit,omitempty" protobuf:"bytes,1,opt,name=limit"`
+	// Backoff is a backoff strategy
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+}
+
+// Backoff is a backoff strategy to use within retryStrategy
+type Backoff struct {
+	// Duration is the amount to back off. Default unit is seconds, but could also be a duration (e.g. "2m", "1h")
+	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+	// Factor is a factor to multiply the base duration after each failed retry
+	Factor int64 `json:"factor,omitempty" protobuf:"bytes,2,opt,name=factor"`
+	// MaxDuration is the maximum amount of time allowed for the backoff strategy
+	MaxDuration string `json:"maxDuration,omitempty" protobuf:"bytes,3,opt,name=maxDuration"`
+}
+
+const (
+	// DefaultSyncRetryDuration is the default base back off duration of a sync retry
+	DefaultSyncRetryDuration = 5 * time.Second
+	// DefaultSyncRetryMaxDuration is the default maximum back off duration of a sync retry
+	DefaultSyncRetryMaxDuration = 3 * time.Minute
+	// DefaultSyncRetryFactor is the default back off factor of a sync retry
+	DefaultSyncRetryFactor = int64(2)
+)
+
+// parseStringToDuration parses a duration which is either a number of seconds or a duration string (e.g. "2m")
+func parseStringToDuration(durationString string) (time.Duration, error) {
+	if seconds, err := strconv.ParseInt(durationString, 10, 64); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	duration, err := time.ParseDuration(durationString)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse %s as a duration", durationString)
+	}
+	return duration, nil
+}
+
+// Validate verifies that the backoff durations of the retry strategy can be parsed
+func (r *RetryStrategy) Validate() error {
+	if r.Backoff == nil {
+		return nil
+	}
+	if r.Backoff.Duration != "" {
+		if _, err := parseStringToDuration(r.Backoff.Duration); err != nil {
+			return fmt.Errorf("invalid retry backoff duration: %v", err)
+		}
+	}
+	if r.Backoff.MaxDuration != "" {
+		if _, err := parseStringToDuration(r.Backoff.MaxDuration); err != nil {
+			return fmt.Errorf("invalid retry backoff max duration: %v", err)
+		}
+	}
+	if r.Backoff.Factor < 0 {
+		return fmt.Errorf("invalid retry backoff factor: %d", r.Backoff.Factor)
+	}
+	return nil
+}
+
+// CanRetry returns whether another attempt is allowed after the given number of retries
+func (r *RetryStrategy) CanRetry(retryCount int64) bool {
+	return r.Limit < 0 || retryCount < r.Limit
+}
+
+// NextRetryAt calculates the time of the next attempt, given the time of the last failed attempt
+// and the number of retries which were performed so far
+func (r *RetryStrategy) NextRetryAt(lastAttempt time.Time, retryCount int64) (time.Time, error) {
+	maxDuration := DefaultSyncRetryMaxDuration
+	duration := DefaultSyncRetryDuration
+	factor := DefaultSyncRetryFactor
+	var err error
+	if r.Backoff != nil {
+		if r.Backoff.Duration != "" {
+			if duration, err = parseStringToDuration(r.Backoff.Duration); err != nil {
+				return time.Time{}, err
+			}
+		}
+		if r.Backoff.MaxDuration != "" {
+			if maxDuration, err = parseStringToDuration(r.Backoff.MaxDuration); err != nil {
+				return time.Time{}, err
+			}
+		}
+		if r.Backoff.Factor > 0 {
+			factor = r.Backoff.Factor
+		}
+	}
+	// Formula: duration * factor^retryCount, capped at maxDuration
+	backoff := duration
+	for i := int64(0); i < retryCount && backoff < maxDuration; i++ {
+		backoff = backoff * time.Duration(factor)
+	}
+	if backoff > maxDuration {
+		backoff = maxDuration
+	}
+	return lastAttempt.Add(backoff), nil
 }
 
 // SyncPolicyAutomated controls the behavior of an automated sync
 type SyncPolicyAutomated struct {
 	// Prune will prune resources automatically as part of automated sync (default: false)
 	Prune bool `json:"prune,omitempty" protobuf:"bytes,1,opt,name=prune"`
+	// SelfHeal specifies whether to revert resources back to their desired state upon modification in the cluster (default: false)
+	SelfHeal bool `json:"selfHeal,omitempty" protobuf:"bytes,2,opt,name=selfHeal"`
+	// PruneLimit is the maximum number of resources an automated sync is allowed to prune. Syncs which would prune more resources have to be performed manually (default: no limit)
+	PruneLimit int64 `json:"pruneLimit,omitempty" protobuf:"bytes,3,opt,name=pruneLimit"`
+	// PrunePercentLimit is the maximum percentage of the application's resources an automated sync is allowed to prune. Syncs which would prune more resources have to be performed manually (default: no limit)
+	PrunePercentLimit int64 `json:"prunePercentLimit,omitempty" protobuf:"bytes,4,opt,name=prunePercentLimit"`
+}
+
+// Validate verifies that the prune limits of the automated sync policy are within range
+func (a *SyncPolicyAutomated) Validate() error {
+	if a.PruneLimit < 0 {
+		return fmt.Errorf("invalid prune limit: %d", a.PruneLimit)
+	}
+	if a.PrunePercentLimit < 0 || a.PrunePercentLimit > 100 {
+		return fmt.Errorf("invalid prune percent limit: %d", a.PrunePercentLimit)
+	}
+	return nil
 }
 
 // SyncStrategy controls the manner in which a sync is performed
@@ -322,12 +614,33 @@ type SyncStrategy struct {
 	Hook *SyncStrategyHook `json:"hook,omitempty" protobuf:"bytes,2,opt,name=hook"`
 }
 
+// ApplyOptions returns the apply options of the sync strategy, or nil if no strategy is specified
+func (s *SyncStrategy) ApplyOptions() *SyncStrategyApply {
+	if s == nil {
+		return nil
+	}
+	if s.Apply != nil {
+		return s.Apply
+	}
+	if s.Hook != nil {
+		return &s.Hook.SyncStrategyApply
+	}
+	return nil
+}
+
 // SyncStrategyApply uses `kubectl apply` to perform the apply
 type SyncStrategyApply struct {
 	// Force indicates whether or not to supply the --force flag to `kubectl apply`.
 	// The --force flag deletes and re-create the resource, when PATCH encounters conflict and has
 	// retried for 5 times.
+	// When ServerSideApply is enabled, Force instead forces conflicts, taking over the ownership of
+	// fields which are managed by other field managers.
 	Force bool `json:"force,omitempty" protobuf:"bytes,1,opt,name=force"`
+	// ServerSideApply indicates whether or not to use server-side apply instead of `kubectl apply`.
+	// Server-side apply tracks field ownership instead of the last-applied-configuration annotation.
+	ServerSideApply bool `json:"serverSideApply,omitempty" protobuf:"bytes,2,opt,name=serverSideApply"`
+	// FieldManager is the field manager used for server-side apply. Defaults to the field manager of the application
+	FieldManager string `json:"fieldManager,omitempty" protobuf:"bytes,3,opt,name=fieldManager"`
 }
 
 // SyncStrategyHook will perform a sync using hooks annotations.
@@ -344,11 +657,16 @@ const (
 	HookTypeSync     HookType = "Sync"
 	HookTypePostSync HookType = "PostSync"
 	HookTypeSkip     HookType = "Skip"
-
-	// NOTE: we may consider adding SyncFail hook. With a SyncFail hook, finalizer-like logic could
-	// be implemented by specifying both PostSync,SyncFail in the hook annotation:
-	// (e.g.: argocd.argoproj.io/hook: PostSync,SyncFail)
-	//HookTypeSyncFail     HookType = "SyncFail"
+	// HookTypeSyncFail hooks are run when any of the PreSync, Sync or PostSync phases failed.
+	// Finalizer-like logic can be implemented by specifying both PostSync,SyncFail in the hook
+	// annotation (e.g.: argocd.argoproj.io/hook: PostSync,SyncFail)
+	HookTypeSyncFail HookType = "SyncFail"
+	// HookTypePreDelete hooks are run during a cascading deletion of the application, before any of
+	// its resources are deleted
+	HookTypePreDelete HookType = "PreDelete"
+	// HookTypePostDelete hooks are run during a cascading deletion of the application, after all of
+	// its resources were deleted
+	HookTypePostDelete HookType = "PostDelete"
 )
 
 type HookDeletePolicy string
@@ -356,6 +674,8 @@ type HookDeletePolicy string
 const (
 	HookDeletePolicyHookSucceeded HookDeletePolicy = "HookSucceeded"
 	HookDeletePolicyHookFailed    HookDeletePolicy = "HookFailed"
+	// HookDeletePolicyBeforeHookCreation deletes an existing instance of the hook from a previous sync before the hook is created again
+	HookDeletePolicyBeforeHookCreation HookDeletePolicy = "BeforeHookCreation"
 )
 
 // SyncOperationResult represent result of sync operation
@@ -366,6 +686,8 @@ type SyncOperationResult struct {
 	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	// Source records the application source information of the sync, used for comparing auto-sync
 	Source ApplicationSource `json:"source" protobuf:"bytes,3,opt,name=source"`
+	// HealthCheckStartedAt contains the time the operation started waiting for the synced resources to become healthy
+	HealthCheckStartedAt *metav1.Time `json:"healthCheckStartedAt,omitempty" protobuf:"bytes,4,opt,name=healthCheckStartedAt"`
 }
 
 type ResultCode string
@@ -392,6 +714,10 @@ type ResourceResult struct {
 	Message   string         `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
 	HookType  HookType       `json:"hookType,omitempty" protobuf:"bytes,8,opt,name=hookType"`
 	HookPhase OperationPhase `json:"hookPhase,omitempty" protobuf:"bytes,9,opt,name=hookPhase"`
+	// UID is the UID of the hook instance created during the sync
+	UID string `json:"uid,omitempty" protobuf:"bytes,10,opt,name=uid"`
+	// GenerateName is the metadata.generateName of hooks which are named by the API server
+	GenerateName string `json:"generateName,omitempty" protobuf:"bytes,11,opt,name=generateName"`
 }
 
 func (r *ResourceResult) IsHook() bool {
@@ -412,6 +738,12 @@ type RevisionHistory struct {
 	DeployedAt metav1.Time       `json:"deployedAt" protobuf:"bytes,4,opt,name=deployedAt"`
 	ID         int64             `json:"id" protobuf:"bytes,5,opt,name=id"`
 	Source     ApplicationSource `json:"source" protobuf:"bytes,6,opt,name=source"`
+	// DeployStartedAt is the time the deployment operation started
+	DeployStartedAt *metav1.Time `json:"deployStartedAt,omitempty" protobuf:"bytes,7,opt,name=deployStartedAt"`
+	// InitiatedBy contains information about who initiated the deployment
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,8,opt,name=initiatedBy"`
+	// Resources holds the sync result of each resource of the deployment
+	Resources []*ResourceResult `json:"resources,omitempty" protobuf:"bytes,9,opt,name=resources"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -460,6 +792,8 @@ type ApplicationConditionType = string
 const (
 	// ApplicationConditionDeletionError indicates that controller failed to delete application
 	ApplicationConditionDeletionError = "DeletionError"
+	// ApplicationConditionDeletionHookWarning indicates that the deletion hooks of the application failed or could not be run, and its resources were deleted regardless
+	ApplicationConditionDeletionHookWarning = "DeletionHookWarning"
 	// ApplicationConditionInvalidSpecError indicates that application source is invalid
 	ApplicationConditionInvalidSpecError = "InvalidSpecError"
 	// ApplicationConditionComparisonError indicates controller failed to compare application state
@@ -472,6 +806,14 @@ const (
 	ApplicationConditionSharedResourceWarning = "SharedResourceWarning"
 	// ApplicationConditionRepeatedResourceWarning indicates that application source has resource with same Group, Kind, Name, Namespace multiple times
 	ApplicationConditionRepeatedResourceWarning = "RepeatedResourceWarning"
+	// ApplicationConditionPruneLimitError indicates that controller refused to automatically sync the application, since more resources would be pruned than permitted by the automated sync policy
+	ApplicationConditionPruneLimitError = "PruneLimitError"
+	// ApplicationConditionSyncWindowWarning indicates that automated syncs of the application are currently denied by a project sync window
+	ApplicationConditionSyncWindowWarning = "SyncWindowWarning"
+	// ApplicationConditionAutoSyncDisabledWarning indicates that automated syncs of the application are currently suspended globally or by its project
+	ApplicationConditionAutoSyncDisabledWarning = "AutoSyncDisabledWarning"
+	// ApplicationConditionOrphanedResourceWarning indicates that the destination namespace of the application contains resources which are not managed by any application
+	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
 )
 
 // ApplicationCondition contains details about current application condition
@@ -530,6 +872,8 @@ type ResourceNetworkingInfo struct {
 // ApplicationTree holds nodes which belongs to the application
 type ApplicationTree struct {
 	Nodes []ResourceNode `json:"nodes,omitempty" protobuf:"bytes,1,rep,name=nodes"`
+	// OrphanedNodes contains resources in the destination namespace of the application which are not managed by any application
+	OrphanedNodes []ResourceNode `json:"orphanedNodes,omitempty" protobuf:"bytes,2,rep,name=orphanedNodes"`
 }
 
 func (t *ApplicationTree) FindNode(group string, kind string, namespace string, name string) *ResourceNode {
@@ -632,6 +976,35 @@ type Cluster struct {
 	Config ClusterConfig `json:"config" protobuf:"bytes,3,opt,name=config"`
 	// ConnectionState contains information about cluster connection state
 	ConnectionState ConnectionState `json:"connectionState,omitempty" protobuf:"bytes,4,opt,name=connectionState"`
+	// Shard contains optional shard number of the application controller replica which manages the cluster.
+	// If omitted, the shard is calculated from the cluster server URL
+	Shard *int64 `json:"shard,omitempty" protobuf:"varint,5,opt,name=shard"`
+	// Namespaces holds list of namespaces which are accessible in that cluster. Cluster level resources are ignored if namespace list is not empty.
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,6,rep,name=namespaces"`
+	// Info holds information about the cluster state and the cluster cache of the application controller
+	Info ClusterInfo `json:"info,omitempty" protobuf:"bytes,7,opt,name=info"`
+}
+
+// ClusterInfo contains information about the cluster
+type ClusterInfo struct {
+	// ServerVersion contains the Kubernetes version of the cluster
+	ServerVersion string `json:"serverVersion,omitempty" protobuf:"bytes,1,opt,name=serverVersion"`
+	// CacheInfo contains information about the cluster cache of the application controller
+	CacheInfo ClusterCacheInfo `json:"cacheInfo,omitempty" protobuf:"bytes,2,opt,name=cacheInfo"`
+	// ApplicationsCount is the number of applications deployed to the cluster
+	ApplicationsCount int64 `json:"applicationsCount" protobuf:"varint,3,opt,name=applicationsCount"`
+}
+
+// ClusterCacheInfo contains information about the cluster cache
+type ClusterCacheInfo struct {
+	// ResourcesCount holds number of observed Kubernetes resources
+	ResourcesCount int64 `json:"resourcesCount,omitempty" protobuf:"varint,1,opt,name=resourcesCount"`
+	// APIsCount holds number of observed Kubernetes API resource types
+	APIsCount int64 `json:"apisCount,omitempty" protobuf:"varint,2,opt,name=apisCount"`
+	// LastCacheSyncTime holds time of most recent cache synchronization
+	LastCacheSyncTime *metav1.Time `json:"lastCacheSyncTime,omitempty" protobuf:"bytes,3,opt,name=lastCacheSyncTime"`
+	// SyncError holds the error of the most recent cache synchronization, if any
+	SyncError string `json:"syncError,omitempty" protobuf:"bytes,4,opt,name=syncError"`
 }
 
 // ClusterList is a collection of Clusters.
@@ -756,6 +1129,70 @@ type AppProjectSpec struct {
 	ClusterResourceWhitelist []metav1.GroupKind `json:"clusterResourceWhitelist,omitempty" protobuf:"bytes,5,opt,name=clusterResourceWhitelist"`
 	// NamespaceResourceBlacklist contains list of blacklisted namespace level resources
 	NamespaceResourceBlacklist []metav1.GroupKind `json:"namespaceResourceBlacklist,omitempty" protobuf:"bytes,6,opt,name=namespaceResourceBlacklist"`
+	// SyncWindows controls when syncs can be run for apps in this project
+	SyncWindows []*SyncWindow `json:"syncWindows,omitempty" protobuf:"bytes,7,opt,name=syncWindows"`
+	// AutoSyncDisabled suspends automated syncs of all applications in this project
+	AutoSyncDisabled bool `json:"autoSyncDisabled,omitempty" protobuf:"varint,8,opt,name=autoSyncDisabled"`
+	// OrphanedResources specifies if the controller should monitor resources in the destination namespaces of applications which are not managed by any application
+	OrphanedResources *OrphanedResourcesMonitorSettings `json:"orphanedResources,omitempty" protobuf:"bytes,9,opt,name=orphanedResources"`
+}
+
+// OrphanedResourcesMonitorSettings holds the settings of orphaned resources monitoring
+type OrphanedResourcesMonitorSettings struct {
+	// Warn indicates if a warning condition should be created for applications which have orphaned resources (default true)
+	Warn *bool `json:"warn,omitempty" protobuf:"varint,1,opt,name=warn"`
+	// Ignore contains a list of resources which are excluded from orphaned resources monitoring
+	Ignore []OrphanedResourceKey `json:"ignore,omitempty" protobuf:"bytes,2,rep,name=ignore"`
+}
+
+// OrphanedResourceKey is a reference to resources which are excluded from orphaned resources monitoring.
+// Kind and name support glob patterns, an empty kind or name matches all resources.
+type OrphanedResourceKey struct {
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind  string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind"`
+	Name  string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+}
+
+// IsWarn returns true if a warning condition should be created for applications which have orphaned resources
+func (s *OrphanedResourcesMonitorSettings) IsWarn() bool {
+	return s.Warn == nil || *s.Warn
+}
+
+// IsIgnored returns true if the given resource is excluded from orphaned resources monitoring
+func (s *OrphanedResourcesMonitorSettings) IsIgnored(group, kind, name string) bool {
+	for _, item := range s.Ignore {
+		if item.Group == group && (item.Kind == "" || globMatch(item.Kind, kind)) && (item.Name == "" || globMatch(item.Name, name)) {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	// SyncWindowKindAllow is a sync window during which syncs are allowed
+	SyncWindowKindAllow = "allow"
+	// SyncWindowKindDeny is a sync window during which syncs are denied
+	SyncWindowKindDeny = "deny"
+)
+
+// SyncWindow is a time window in which syncs are either allowed or denied for the matching applications
+type SyncWindow struct {
+	// Kind defines if the window allows or denies syncs
+	Kind string `json:"kind,omitempty" protobuf:"bytes,1,opt,name=kind"`
+	// Schedule is the time the window begins, specified in cron format
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,2,opt,name=schedule"`
+	// Duration is the amount of time the window is open (e.g. 1h)
+	Duration string `json:"duration,omitempty" protobuf:"bytes,3,opt,name=duration"`
+	// Applications contains a list of application name globs the window applies to
+	Applications []string `json:"applications,omitempty" protobuf:"bytes,4,rep,name=applications"`
+	// Namespaces contains a list of destination namespace globs the window applies to
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,5,rep,name=namespaces"`
+	// Clusters contains a list of destination cluster URL globs the window applies to
+	Clusters []string `json:"clusters,omitempty" protobuf:"bytes,6,rep,name=clusters"`
+	// ManualSync allows manual syncs while the window would otherwise deny syncs
+	ManualSync bool `json:"manualSync,omitempty" protobuf:"bytes,7,opt,name=manualSync"`
+	// TimeZone is the IANA time zone the schedule is evaluated in (e.g. "Europe/Berlin"). Defaults to UTC
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,8,opt,name=timeZone"`
 }
 
 // ProjectRole represents a role that has access to a project
@@ -805,9 +1242,11 @@ func (proj *AppProject) ProjectPoliciesString() string {
 	return strings.Join(policies, "\n")
 }
 
-func (app *Application) getFinalizerIndex(name string) int {
+// getResourcesFinalizerIndex returns the index of the resources finalizer, regardless of the deletion
+// propagation policy it specifies
+func (app *Application) getResourcesFinalizerIndex() int {
 	for i, finalizer := range app.Finalizers {
-		if finalizer == name {
+		if finalizer == common.ResourcesFinalizerName || strings.HasPrefix(finalizer, common.ResourcesFinalizerName+"/") {
 			return i
 		}
 	}
@@ -816,7 +1255,32 @@ func (app *Application) getFinalizerIndex(name string) int {
 
 // CascadedDeletion indicates if resources finalizer is set and controller should delete app resources before deleting app
 func (app *Application) CascadedDeletion() bool {
-	return app.getFinalizerIndex(common.ResourcesFinalizerName) > -1
+	return app.getResourcesFinalizerIndex() > -1
+}
+
+// DeletionPropagationPolicy returns the propagation policy used to delete the app resources during a
+// cascaded deletion. The policy is specified as a suffix of the resources finalizer (e.g.
+// resources-finalizer.argocd.argoproj.io/background) and defaults to foreground deletion.
+func (app *Application) DeletionPropagationPolicy() metav1.DeletionPropagation {
+	index := app.getResourcesFinalizerIndex()
+	if index > -1 {
+		policy, err := ParseDeletionPropagationPolicy(strings.TrimPrefix(app.Finalizers[index], common.ResourcesFinalizerName+"/"))
+		if err == nil {
+			return policy
+		}
+	}
+	return metav1.DeletePropagationForeground
+}
+
+// ParseDeletionPropagationPolicy parses a deletion propagation policy (foreground, background or
+// orphan), ignoring the case
+func ParseDeletionPropagationPolicy(policy string) (metav1.DeletionPropagation, error) {
+	for _, p := range []metav1.DeletionPropagation{metav1.DeletePropagationForeground, metav1.DeletePropagationBackground, metav1.DeletePropagationOrphan} {
+		if strings.EqualFold(string(p), policy) {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("invalid propagation policy '%s': must be one of foreground, background or orphan", policy)
 }
 
 func (app *Application) IsRefreshRequested() (RefreshType, bool) {
@@ -838,9 +1302,18 @@ func (app *Application) IsRefreshRequested() (RefreshType, bool) {
 	return refreshType, true
 }
 
+// GetFieldManager returns the field manager which owns the fields of the resources synced with
+// server-side apply, as configured in the sync policy of the application.
+func (app *Application) GetFieldManager() string {
+	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.FieldManager != "" {
+		return app.Spec.SyncPolicy.FieldManager
+	}
+	return common.ArgoCDFieldManager
+}
+
 // SetCascadedDeletion sets or remove resources finalizer
 func (app *Application) SetCascadedDeletion(prune bool) {
-	index := app.getFinalizerIndex(common.ResourcesFinalizerName)
+	index := app.getResourcesFinalizerIndex()
 	if prune != (index > -1) {
 		if index > -1 {
 			app.Finalizers[index] = app.Finalizers[len(app.Finalizers)-1]
@@ -851,6 +1324,21 @@ func (app *Application) SetCascadedDeletion(prune bool) {
 	}
 }
 
+// SetDeletionPropagationPolicy sets the resources finalizer, specifying the propagation policy used to
+// delete the app resources. The plain resources finalizer is used for foreground deletion, which keeps
+// it compatible with controllers unaware of propagation policies.
+func (app *Application) SetDeletionPropagationPolicy(policy metav1.DeletionPropagation) {
+	finalizer := common.ResourcesFinalizerName
+	if policy != metav1.DeletePropagationForeground {
+		finalizer = common.ResourcesFinalizerName + "/" + strings.ToLower(string(policy))
+	}
+	if index := app.getResourcesFinalizerIndex(); index > -1 {
+		app.Finalizers[index] = finalizer
+	} else {
+		app.Finalizers = append(app.Finalizers, finalizer)
+	}
+}
+
 // GetErrorConditions returns list of application error conditions
 func (status *ApplicationStatus) GetErrorConditions() []ApplicationCondition {
 	result := make([]ApplicationCondition, 0)
@@ -917,6 +1405,18 @@ func (spec ApplicationSpec) GetProject() string {
 	return spec.Project
 }
 
+// GetRevisionHistoryLimit returns the maximum number of deployments kept in the application's history.
+// Negative limits are treated as zero.
+func (spec ApplicationSpec) GetRevisionHistoryLimit() int {
+	if spec.RevisionHistoryLimit == nil {
+		return common.RevisionHistoryLimit
+	}
+	if *spec.RevisionHistoryLimit < 0 {
+		return 0
+	}
+	return int(*spec.RevisionHistoryLimit)
+}
+
 func isResourceInList(res metav1.GroupKind, list []metav1.GroupKind) bool {
 	for _, item := range list {
 		ok, err := filepath.Match(item.Kind, res.Kind)
@@ -971,6 +1471,121 @@ func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
 	return false
 }
 
+// Validate checks the kind, schedule and duration of the sync window
+func (w *SyncWindow) Validate() error {
+	if w.Kind != SyncWindowKindAllow && w.Kind != SyncWindowKindDeny {
+		return fmt.Errorf("kind '%s' is invalid: must be '%s' or '%s'", w.Kind, SyncWindowKindAllow, SyncWindowKindDeny)
+	}
+	_, _, _, err := w.parse()
+	return err
+}
+
+func (w *SyncWindow) parse() (*cron.Schedule, *time.Location, time.Duration, error) {
+	schedule, err := cron.Parse(w.Schedule)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("schedule is invalid: %v", err)
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("time zone '%s' is invalid: %v", w.TimeZone, err)
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("duration '%s' is invalid: %v", w.Duration, err)
+	}
+	if duration <= 0 {
+		return nil, nil, 0, fmt.Errorf("duration '%s' is invalid: must be positive", w.Duration)
+	}
+	return schedule, loc, duration, nil
+}
+
+// Active returns whether or not the window is open at the given time. The schedule is evaluated in
+// the time zone of the window.
+func (w *SyncWindow) Active(now time.Time) bool {
+	schedule, loc, duration, err := w.parse()
+	if err != nil {
+		return false
+	}
+	return !schedule.Prev(now.In(loc), duration).IsZero()
+}
+
+// Matches returns whether or not the window applies to the given application
+func (w *SyncWindow) Matches(app *Application) bool {
+	for _, pattern := range w.Applications {
+		if globMatch(pattern, app.Name) {
+			return true
+		}
+	}
+	for _, pattern := range w.Namespaces {
+		if globMatch(pattern, app.Spec.Destination.Namespace) {
+			return true
+		}
+	}
+	for _, pattern := range w.Clusters {
+		if globMatch(pattern, app.Spec.Destination.Server) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns a short description of the window
+func (w *SyncWindow) String() string {
+	if w.TimeZone != "" {
+		return fmt.Sprintf("%s window '%s' (%s, %s)", w.Kind, w.Schedule, w.Duration, w.TimeZone)
+	}
+	return fmt.Sprintf("%s window '%s' (%s)", w.Kind, w.Schedule, w.Duration)
+}
+
+// CanSync returns whether or not the sync windows of the project permit a sync of the given
+// application at the given time. If the sync is denied, a message describing the reason is returned.
+// Deny windows take precedence over allow windows. If any allow window matches the application,
+// syncs are only permitted while one of those windows is open.
+func (proj AppProject) CanSync(app *Application, manual bool, now time.Time) (bool, string) {
+	var allowWindows []*SyncWindow
+	allowActive := false
+	for _, w := range proj.Spec.SyncWindows {
+		if !w.Matches(app) {
+			continue
+		}
+		switch w.Kind {
+		case SyncWindowKindDeny:
+			if w.Active(now) && !(manual && w.ManualSync) {
+				return false, fmt.Sprintf("Syncs are denied by the active %s", w)
+			}
+		case SyncWindowKindAllow:
+			allowWindows = append(allowWindows, w)
+			if w.Active(now) {
+				allowActive = true
+			}
+		}
+	}
+	if len(allowWindows) == 0 || allowActive {
+		return true, ""
+	}
+	if manual {
+		for _, w := range allowWindows {
+			if w.ManualSync {
+				return true, ""
+			}
+		}
+	}
+	return false, "Syncs are denied since no allow window is active"
+}
+
+// IsNamespaceAccessible returns true if resources of the given namespace are accessible in the cluster
+func (c *Cluster) IsNamespaceAccessible(namespace string) bool {
+	if len(c.Namespaces) == 0 {
+		return true
+	}
+	for _, ns := range c.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
 // RESTConfig returns a go-client REST config from cluster
 func (c *Cluster) RESTConfig() *rest.Config {
 	var config *rest.Config
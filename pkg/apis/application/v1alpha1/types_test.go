@@ -2,8 +2,12 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/common"
 )
 
 func TestAppProject_IsSourcePermitted(t *testing.T) {
@@ -171,3 +175,201 @@ func TestAppDestinationEquality(t *testing.T) {
 	right.Namespace = "kube-system"
 	assert.False(t, left.Equals(*right))
 }
+
+func TestRetryStrategy_NextRetryAt(t *testing.T) {
+	lastAttempt := time.Now()
+	retry := RetryStrategy{Limit: 3}
+	// defaults: 5s duration, factor 2, 3m max
+	retryAt, err := retry.NextRetryAt(lastAttempt, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, lastAttempt.Add(5*time.Second), retryAt)
+	retryAt, err = retry.NextRetryAt(lastAttempt, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, lastAttempt.Add(20*time.Second), retryAt)
+	retryAt, err = retry.NextRetryAt(lastAttempt, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, lastAttempt.Add(3*time.Minute), retryAt)
+
+	retry.Backoff = &Backoff{Duration: "10", Factor: 3, MaxDuration: "1m"}
+	retryAt, err = retry.NextRetryAt(lastAttempt, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, lastAttempt.Add(30*time.Second), retryAt)
+	retryAt, err = retry.NextRetryAt(lastAttempt, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, lastAttempt.Add(time.Minute), retryAt)
+
+	retry.Backoff.Duration = "foo"
+	_, err = retry.NextRetryAt(lastAttempt, 0)
+	assert.Error(t, err)
+	assert.Error(t, retry.Validate())
+}
+
+func TestRetryStrategy_CanRetry(t *testing.T) {
+	assert.False(t, (&RetryStrategy{}).CanRetry(0))
+	assert.True(t, (&RetryStrategy{Limit: 2}).CanRetry(1))
+	assert.False(t, (&RetryStrategy{Limit: 2}).CanRetry(2))
+	assert.True(t, (&RetryStrategy{Limit: -1}).CanRetry(100))
+}
+
+func TestAppProject_CanSync(t *testing.T) {
+	app := &Application{}
+	app.Name = "guestbook"
+	app.Spec.Destination = ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "prod"}
+	// 22:30 UTC
+	now := time.Date(2019, 6, 1, 22, 30, 0, 0, time.UTC)
+
+	proj := AppProject{}
+	canSync, _ := proj.CanSync(app, false, now)
+	assert.True(t, canSync)
+
+	// active deny window
+	proj.Spec.SyncWindows = []*SyncWindow{{Kind: SyncWindowKindDeny, Schedule: "0 22 * * *", Duration: "1h", Namespaces: []string{"prod"}}}
+	canSync, message := proj.CanSync(app, false, now)
+	assert.False(t, canSync)
+	assert.Contains(t, message, "deny window")
+	canSync, _ = proj.CanSync(app, true, now)
+	assert.False(t, canSync)
+	proj.Spec.SyncWindows[0].ManualSync = true
+	canSync, _ = proj.CanSync(app, true, now)
+	assert.True(t, canSync)
+
+	// inactive deny window
+	canSync, _ = proj.CanSync(app, false, now.Add(time.Hour))
+	assert.True(t, canSync)
+
+	// deny window which does not match the application
+	proj.Spec.SyncWindows[0].Namespaces = []string{"staging-*"}
+	canSync, _ = proj.CanSync(app, false, now)
+	assert.True(t, canSync)
+
+	// matching allow window
+	proj.Spec.SyncWindows = []*SyncWindow{{Kind: SyncWindowKindAllow, Schedule: "0 22 * * *", Duration: "1h", Applications: []string{"guest*"}}}
+	canSync, _ = proj.CanSync(app, false, now)
+	assert.True(t, canSync)
+	canSync, message = proj.CanSync(app, false, now.Add(time.Hour))
+	assert.False(t, canSync)
+	assert.Equal(t, "Syncs are denied since no allow window is active", message)
+	proj.Spec.SyncWindows[0].ManualSync = true
+	canSync, _ = proj.CanSync(app, true, now.Add(time.Hour))
+	assert.True(t, canSync)
+
+	// deny windows take precedence over allow windows
+	proj.Spec.SyncWindows = append(proj.Spec.SyncWindows, &SyncWindow{Kind: SyncWindowKindDeny, Schedule: "0 22 * * *", Duration: "1h", Clusters: []string{"*"}})
+	canSync, _ = proj.CanSync(app, false, now)
+	assert.False(t, canSync)
+}
+
+func TestSyncWindow_Active(t *testing.T) {
+	// 22:30 UTC, 00:30 in Europe/Berlin
+	now := time.Date(2019, 6, 1, 22, 30, 0, 0, time.UTC)
+	window := SyncWindow{Kind: SyncWindowKindDeny, Schedule: "0 22 * * *", Duration: "1h"}
+	assert.True(t, window.Active(now))
+	// the window is evaluated in UTC regardless of the location of the given time
+	assert.True(t, window.Active(now.In(time.FixedZone("UTC+5", 5*60*60))))
+
+	window.TimeZone = "Europe/Berlin"
+	assert.NoError(t, window.Validate())
+	assert.False(t, window.Active(now))
+	assert.True(t, window.Active(now.Add(-2*time.Hour)))
+
+	window.TimeZone = "Mars/Olympus_Mons"
+	assert.Error(t, window.Validate())
+	assert.False(t, window.Active(now))
+}
+
+func TestApplication_DeletionPropagationPolicy(t *testing.T) {
+	app := Application{}
+	assert.False(t, app.CascadedDeletion())
+
+	app.SetCascadedDeletion(true)
+	assert.True(t, app.CascadedDeletion())
+	assert.Equal(t, metav1.DeletePropagationForeground, app.DeletionPropagationPolicy())
+	assert.Equal(t, []string{common.ResourcesFinalizerName}, app.Finalizers)
+
+	app.SetDeletionPropagationPolicy(metav1.DeletePropagationOrphan)
+	assert.True(t, app.CascadedDeletion())
+	assert.Equal(t, metav1.DeletePropagationOrphan, app.DeletionPropagationPolicy())
+	assert.Equal(t, []string{common.ResourcesFinalizerName + "/orphan"}, app.Finalizers)
+
+	app.SetCascadedDeletion(false)
+	assert.False(t, app.CascadedDeletion())
+	assert.Empty(t, app.Finalizers)
+
+	_, err := ParseDeletionPropagationPolicy("Background")
+	assert.NoError(t, err)
+	_, err = ParseDeletionPropagationPolicy("cascade")
+	assert.Error(t, err)
+}
+
+func TestSyncSchedule_Due(t *testing.T) {
+	schedule := &SyncSchedule{Cron: "0 2 * * *", TimeZone: "Europe/Berlin"}
+	assert.NoError(t, schedule.Validate())
+	// 02:30 in Berlin (UTC+2)
+	now := time.Date(2019, 6, 1, 0, 30, 0, 0, time.UTC)
+
+	due, err := schedule.Due(now, nil)
+	assert.NoError(t, err)
+	assert.True(t, due)
+
+	// a sync was already attempted since the scheduled time
+	lastAttempt := metav1.NewTime(now.Add(-10 * time.Minute))
+	due, _ = schedule.Due(now, &lastAttempt)
+	assert.False(t, due)
+
+	// the last sync attempt was before the scheduled time
+	lastAttempt = metav1.NewTime(now.Add(-time.Hour))
+	due, _ = schedule.Due(now, &lastAttempt)
+	assert.True(t, due)
+
+	// the scheduled time is more than the duration ago
+	due, _ = schedule.Due(now.Add(time.Hour), nil)
+	assert.False(t, due)
+	schedule.Duration = "2h"
+	due, _ = schedule.Due(now.Add(time.Hour), nil)
+	assert.True(t, due)
+
+	next, err := schedule.NextRun(now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 6, 2, 0, 0, 0, 0, time.UTC), next.UTC())
+
+	assert.Error(t, (&SyncSchedule{Cron: "0 2 * * *", TimeZone: "Mars/Olympus"}).Validate())
+	assert.Error(t, (&SyncSchedule{Cron: "0 2 * *"}).Validate())
+}
+
+func TestCluster_IsNamespaceAccessible(t *testing.T) {
+	cluster := &Cluster{Server: "https://kubernetes.default.svc"}
+	assert.True(t, cluster.IsNamespaceAccessible("default"))
+
+	cluster.Namespaces = []string{"ns1", "ns2"}
+	assert.True(t, cluster.IsNamespaceAccessible("ns2"))
+	assert.False(t, cluster.IsNamespaceAccessible("default"))
+}
+
+func TestApplicationSpec_GetRevisionHistoryLimit(t *testing.T) {
+	spec := ApplicationSpec{}
+	assert.Equal(t, common.RevisionHistoryLimit, spec.GetRevisionHistoryLimit())
+
+	limit := int64(3)
+	spec.RevisionHistoryLimit = &limit
+	assert.Equal(t, 3, spec.GetRevisionHistoryLimit())
+
+	limit = -1
+	assert.Equal(t, 0, spec.GetRevisionHistoryLimit())
+}
+
+func TestApplication_GetFieldManager(t *testing.T) {
+	app := &Application{}
+	assert.Equal(t, common.ArgoCDFieldManager, app.GetFieldManager())
+
+	app.Spec.SyncPolicy = &SyncPolicy{}
+	assert.Equal(t, common.ArgoCDFieldManager, app.GetFieldManager())
+
+	app.Spec.SyncPolicy.FieldManager = "custom-manager"
+	assert.Equal(t, "custom-manager", app.GetFieldManager())
+
+	// the field manager of a sync operation does not change the fields owned by the application
+	app.Status.OperationState = &OperationState{Operation: Operation{Sync: &SyncOperation{
+		SyncStrategy: &SyncStrategy{Hook: &SyncStrategyHook{SyncStrategyApply{ServerSideApply: true, FieldManager: "other-manager"}}},
+	}}}
+	assert.Equal(t, "custom-manager", app.GetFieldManager())
+}
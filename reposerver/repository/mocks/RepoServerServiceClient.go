@@ -131,3 +131,33 @@ func (_m *RepoServerServiceClient) ListDir(ctx context.Context, in *repository.L
 
 	return r0, r1
 }
+
+// ResolveRevision provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) ResolveRevision(ctx context.Context, in *repository.ResolveRevisionRequest, opts ...grpc.CallOption) (*repository.ResolveRevisionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *repository.ResolveRevisionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.ResolveRevisionRequest, ...grpc.CallOption) *repository.ResolveRevisionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.ResolveRevisionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.ResolveRevisionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -500,6 +500,35 @@ func (s *Service) newClientResolveRevision(repo *v1alpha1.Repository, revision s
 	return gitClient, commitSHA, nil
 }
 
+// ResolveRevision resolves a revision of a repository into a commit SHA. If AncestorOf is set, the
+// commit has to be reachable from that revision, e.g. from the branch tracked by an application.
+func (s *Service) ResolveRevision(ctx context.Context, q *ResolveRevisionRequest) (*ResolveRevisionResponse, error) {
+	gitClient, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Revision)
+	if err != nil {
+		return nil, err
+	}
+	s.repoLock.Lock(gitClient.Root())
+	defer s.repoLock.Unlock(gitClient.Root())
+	commitSHA, err = checkoutRevision(gitClient, commitSHA)
+	if err != nil {
+		return nil, err
+	}
+	if q.AncestorOf != "" {
+		ancestorOfSHA, err := gitClient.LsRemote(q.AncestorOf)
+		if err != nil {
+			return nil, err
+		}
+		isAncestor, err := gitClient.IsAncestor(commitSHA, ancestorOfSHA)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to verify revision %s: %v", q.Revision, err)
+		}
+		if !isAncestor {
+			return nil, status.Errorf(codes.InvalidArgument, "revision %s is not reachable from %s", q.Revision, q.AncestorOf)
+		}
+	}
+	return &ResolveRevisionResponse{Revision: commitSHA}, nil
+}
+
 func runCommand(command v1alpha1.Command, path string, env []string) (string, error) {
 	if len(command.Command) == 0 {
 		return "", fmt.Errorf("Command is empty")
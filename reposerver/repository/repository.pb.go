@@ -1040,6 +1040,118 @@ func (m *DirectoryAppSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DirectoryAppSpec proto.InternalMessageInfo
 
+// ResolveRevisionRequest is a query to resolve a revision of a repository into a commit SHA
+type ResolveRevisionRequest struct {
+	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Revision             string               `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	AncestorOf           string               `protobuf:"bytes,3,opt,name=ancestorOf,proto3" json:"ancestorOf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ResolveRevisionRequest) Reset()         { *m = ResolveRevisionRequest{} }
+func (m *ResolveRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveRevisionRequest) ProtoMessage()    {}
+func (*ResolveRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_ca2a992ee8cf91c1, []int{15}
+}
+func (m *ResolveRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolveRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolveRevisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResolveRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveRevisionRequest.Merge(dst, src)
+}
+func (m *ResolveRevisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolveRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveRevisionRequest proto.InternalMessageInfo
+
+func (m *ResolveRevisionRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *ResolveRevisionRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *ResolveRevisionRequest) GetAncestorOf() string {
+	if m != nil {
+		return m.AncestorOf
+	}
+	return ""
+}
+
+// ResolveRevisionResponse returns the commit SHA of a ResolveRevision request
+type ResolveRevisionResponse struct {
+	Revision             string   `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolveRevisionResponse) Reset()         { *m = ResolveRevisionResponse{} }
+func (m *ResolveRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*ResolveRevisionResponse) ProtoMessage()    {}
+func (*ResolveRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_ca2a992ee8cf91c1, []int{16}
+}
+func (m *ResolveRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolveRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolveRevisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResolveRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveRevisionResponse.Merge(dst, src)
+}
+func (m *ResolveRevisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolveRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveRevisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveRevisionResponse proto.InternalMessageInfo
+
+func (m *ResolveRevisionResponse) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
@@ -1057,6 +1169,8 @@ func init() {
 	proto.RegisterType((*KsonnetEnvironment)(nil), "repository.KsonnetEnvironment")
 	proto.RegisterType((*KsonnetEnvironmentDestination)(nil), "repository.KsonnetEnvironmentDestination")
 	proto.RegisterType((*DirectoryAppSpec)(nil), "repository.DirectoryAppSpec")
+	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
+	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1078,6 +1192,8 @@ type RepoServerServiceClient interface {
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error)
 	// Generate manifest for application in specified repo name and revision
 	GetAppDetails(ctx context.Context, in *RepoServerAppDetailsQuery, opts ...grpc.CallOption) (*RepoAppDetailsResponse, error)
+	// ResolveRevision resolves a revision of a repository into a commit SHA
+	ResolveRevision(ctx context.Context, in *ResolveRevisionRequest, opts ...grpc.CallOption) (*ResolveRevisionResponse, error)
 }
 
 type repoServerServiceClient struct {
@@ -1124,6 +1240,15 @@ func (c *repoServerServiceClient) GetAppDetails(ctx context.Context, in *RepoSer
 	return out, nil
 }
 
+func (c *repoServerServiceClient) ResolveRevision(ctx context.Context, in *ResolveRevisionRequest, opts ...grpc.CallOption) (*ResolveRevisionResponse, error) {
+	out := new(ResolveRevisionResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/ResolveRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RepoServerService service
 
 type RepoServerServiceServer interface {
@@ -1135,6 +1260,8 @@ type RepoServerServiceServer interface {
 	GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error)
 	// Generate manifest for application in specified repo name and revision
 	GetAppDetails(context.Context, *RepoServerAppDetailsQuery) (*RepoAppDetailsResponse, error)
+	// ResolveRevision resolves a revision of a repository into a commit SHA
+	ResolveRevision(context.Context, *ResolveRevisionRequest) (*ResolveRevisionResponse, error)
 }
 
 func RegisterRepoServerServiceServer(s *grpc.Server, srv RepoServerServiceServer) {
@@ -1213,6 +1340,24 @@ func _RepoServerService_GetAppDetails_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_ResolveRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).ResolveRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/ResolveRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).ResolveRevision(ctx, req.(*ResolveRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "repository.RepoServerService",
 	HandlerType: (*RepoServerServiceServer)(nil),
@@ -1233,6 +1378,10 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAppDetails",
 			Handler:    _RepoServerService_GetAppDetails_Handler,
 		},
+		{
+			MethodName: "ResolveRevision",
+			Handler:    _RepoServerService_ResolveRevision_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "reposerver/repository/repository.proto",
@@ -2016,6 +2165,76 @@ func (m *DirectoryAppSpec) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ResolveRevisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolveRevisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
+		n13, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
+	}
+	if len(m.AncestorOf) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.AncestorOf)))
+		i += copy(dAtA[i:], m.AncestorOf)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResolveRevisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolveRevisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -2396,6 +2615,40 @@ func (m *DirectoryAppSpec) Size() (n int) {
 	return n
 }
 
+func (m *ResolveRevisionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.AncestorOf)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResolveRevisionResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRepository(x uint64) (n int) {
 	for {
 		n++
@@ -4798,6 +5051,228 @@ func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolveRevisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolveRevisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AncestorOf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AncestorOf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolveRevisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolveRevisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRepository(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4908,73 +5383,76 @@ func init() {
 }
 
 var fileDescriptor_repository_ca2a992ee8cf91c1 = []byte{
-	// 1075 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x17, 0x4d, 0x6f, 0x1b, 0x45,
-	0xb4, 0x6b, 0x3b, 0x1f, 0x7e, 0x4e, 0xdb, 0x64, 0x88, 0xca, 0xe2, 0x06, 0x63, 0xad, 0x28, 0x0a,
-	0x02, 0xd6, 0x8a, 0x5b, 0xa4, 0xa8, 0x12, 0x42, 0xa1, 0x09, 0x69, 0x94, 0x54, 0xa4, 0x9b, 0x52,
-	0x09, 0x84, 0x54, 0x4d, 0xd6, 0xaf, 0xeb, 0xa9, 0xed, 0x9d, 0x61, 0x67, 0x6c, 0xc9, 0xfd, 0x03,
-	0xfc, 0x08, 0x6e, 0xdc, 0xe0, 0xc6, 0x2f, 0xe0, 0x08, 0x47, 0x8e, 0x88, 0x13, 0xca, 0x2f, 0x41,
-	0x33, 0xde, 0xb5, 0x67, 0x9d, 0x6d, 0x84, 0x64, 0x01, 0xbd, 0xac, 0xde, 0xbc, 0xef, 0xcf, 0x99,
-	0xb7, 0xf0, 0x5e, 0x82, 0x82, 0x4b, 0x4c, 0x46, 0x98, 0xb4, 0x0c, 0xc8, 0x14, 0x4f, 0xc6, 0x16,
-	0xe8, 0x8b, 0x84, 0x2b, 0x4e, 0x60, 0x86, 0xa9, 0x6f, 0x46, 0x3c, 0xe2, 0x06, 0xdd, 0xd2, 0xd0,
-	0x84, 0xa3, 0xbe, 0x15, 0x71, 0x1e, 0xf5, 0xb1, 0x45, 0x05, 0x6b, 0xd1, 0x38, 0xe6, 0x8a, 0x2a,
-	0xc6, 0x63, 0x99, 0x52, 0xbd, 0xde, 0xae, 0xf4, 0x19, 0x37, 0xd4, 0x90, 0x27, 0xd8, 0x1a, 0xed,
-	0xb4, 0x22, 0x8c, 0x31, 0xa1, 0x0a, 0x3b, 0x29, 0xcf, 0x51, 0xc4, 0x54, 0x77, 0x78, 0xee, 0x87,
-	0x7c, 0xd0, 0xa2, 0x89, 0x31, 0xf1, 0xc2, 0x00, 0x1f, 0x85, 0x9d, 0x96, 0xe8, 0x45, 0x5a, 0x58,
-	0xb6, 0xa8, 0x10, 0x7d, 0x16, 0x1a, 0xe5, 0xad, 0xd1, 0x0e, 0xed, 0x8b, 0x2e, 0xbd, 0xa4, 0xca,
-	0xfb, 0xb3, 0x02, 0x37, 0x1f, 0xd1, 0x98, 0x3d, 0x47, 0xa9, 0x02, 0xfc, 0x76, 0x88, 0x52, 0x91,
-	0xaf, 0xa0, 0xa2, 0x83, 0x70, 0x9d, 0xa6, 0xb3, 0x5d, 0x6b, 0x1f, 0xf8, 0x33, 0x6b, 0x7e, 0x66,
-	0xcd, 0x00, 0xcf, 0xc2, 0x8e, 0x2f, 0x7a, 0x91, 0xaf, 0xad, 0xf9, 0x96, 0x35, 0x3f, 0xb3, 0xe6,
-	0x07, 0xd3, 0x5c, 0x04, 0x46, 0x25, 0xa9, 0xc3, 0x6a, 0x82, 0x23, 0x26, 0x19, 0x8f, 0xdd, 0x52,
-	0xd3, 0xd9, 0xae, 0x06, 0xd3, 0x33, 0x71, 0x61, 0x25, 0xe6, 0x0f, 0x68, 0xd8, 0x45, 0xb7, 0xdc,
-	0x74, 0xb6, 0x57, 0x83, 0xec, 0x48, 0x9a, 0x50, 0xa3, 0x42, 0x9c, 0xd0, 0x73, 0xec, 0x1f, 0xe3,
-	0xd8, 0xad, 0x18, 0x41, 0x1b, 0x45, 0xde, 0x85, 0xeb, 0xd9, 0xf1, 0x29, 0xed, 0x0f, 0xd1, 0x5d,
-	0x32, 0x3c, 0x79, 0x24, 0xd9, 0x82, 0x6a, 0x4c, 0x07, 0x28, 0x05, 0x0d, 0xd1, 0x5d, 0x35, 0x1c,
-	0x33, 0x04, 0x79, 0x09, 0x1b, 0x56, 0x10, 0x67, 0x7c, 0x98, 0x84, 0xe8, 0x82, 0xc9, 0xc1, 0xc9,
-	0x02, 0x39, 0xd8, 0x9b, 0xd7, 0x19, 0x5c, 0x36, 0x43, 0x22, 0xa8, 0x76, 0xb1, 0x3f, 0x30, 0xf9,
-	0x72, 0x6b, 0xcd, 0xf2, 0x76, 0xad, 0x7d, 0xb4, 0x80, 0xcd, 0x87, 0x99, 0xae, 0x49, 0xee, 0x67,
-	0xba, 0x49, 0x0f, 0x56, 0x44, 0x7f, 0x18, 0xb1, 0x58, 0xba, 0x6b, 0xc6, 0xcc, 0xe3, 0x05, 0xcc,
-	0x3c, 0xe0, 0xf1, 0x73, 0x16, 0x3d, 0xa2, 0x31, 0x8d, 0x70, 0x80, 0xb1, 0x3a, 0x35, 0x9a, 0x83,
-	0xcc, 0x82, 0xf7, 0x83, 0x03, 0xeb, 0xb3, 0xe6, 0x92, 0x82, 0xc7, 0xd2, 0x14, 0x61, 0x90, 0xe2,
-	0xa4, 0xeb, 0x34, 0xcb, 0xba, 0x08, 0x53, 0x44, 0xbe, 0x44, 0xa5, 0xf9, 0x12, 0xdd, 0x82, 0xe5,
-	0xc9, 0x08, 0x9a, 0x0e, 0xa9, 0x06, 0xe9, 0x29, 0xd7, 0x56, 0x95, 0xb9, 0xb6, 0x6a, 0x00, 0x48,
-	0x93, 0xe4, 0x27, 0x63, 0x81, 0xee, 0xb2, 0xa1, 0x5a, 0x18, 0xef, 0x7b, 0x07, 0x6e, 0x9c, 0x30,
-	0xa9, 0xf6, 0x59, 0xf2, 0x3f, 0x0f, 0x00, 0x81, 0x8a, 0xa0, 0xaa, 0x9b, 0xc6, 0x66, 0x60, 0xaf,
-	0x09, 0xab, 0x9f, 0xb3, 0x3e, 0x6a, 0x07, 0xc9, 0x26, 0x2c, 0x31, 0x85, 0x83, 0x2c, 0x6b, 0x93,
-	0x83, 0xf1, 0xff, 0x10, 0x95, 0xe6, 0x7a, 0x0d, 0xfd, 0xbf, 0x03, 0x37, 0xa7, 0xce, 0xa5, 0x0d,
-	0x40, 0xa0, 0xd2, 0xa1, 0x8a, 0x1a, 0xef, 0xd6, 0x02, 0x03, 0x7b, 0x3f, 0x97, 0xe1, 0x2d, 0x6d,
-	0xeb, 0xcc, 0xd4, 0x73, 0x4f, 0x88, 0x7d, 0x54, 0x94, 0xf5, 0xe5, 0xe3, 0x21, 0x26, 0xe3, 0xd7,
-	0x28, 0x9e, 0xfc, 0xa0, 0x56, 0xfe, 0x9b, 0x41, 0x5d, 0xfa, 0xb7, 0x07, 0x95, 0xdc, 0x85, 0x8a,
-	0xb6, 0x6c, 0xa6, 0xa3, 0xd6, 0x7e, 0xc7, 0xb7, 0x5e, 0x35, 0xed, 0xe1, 0x5c, 0x3d, 0x02, 0xc3,
-	0xec, 0x7d, 0x0c, 0x6f, 0x14, 0x10, 0xf5, 0xbc, 0x8d, 0xf4, 0x6d, 0xab, 0x6b, 0x9e, 0xb5, 0xaa,
-	0x85, 0xf1, 0xbe, 0x2b, 0xc1, 0x2d, 0x1d, 0xe2, 0x4c, 0xce, 0xee, 0x0c, 0xa5, 0x87, 0xd4, 0x99,
-	0x24, 0x5c, 0xc3, 0xe4, 0x1e, 0xac, 0xf4, 0x24, 0x8f, 0x63, 0x54, 0xa6, 0x3e, 0xb5, 0x76, 0xdd,
-	0xf6, 0xee, 0x78, 0x42, 0xda, 0x13, 0xe2, 0x4c, 0x60, 0x18, 0x64, 0xac, 0xe4, 0x83, 0x34, 0xa0,
-	0xb2, 0x11, 0x79, 0xb3, 0x20, 0x20, 0xc3, 0x6f, 0x98, 0xc8, 0x7d, 0xa8, 0xf6, 0x86, 0x52, 0xf1,
-	0x01, 0x7b, 0x89, 0xe6, 0xfa, 0xa8, 0xb5, 0xb7, 0x72, 0x46, 0x32, 0x62, 0x26, 0x36, 0x63, 0xd7,
-	0xb2, 0x1d, 0x96, 0x60, 0xa8, 0x19, 0xcd, 0xa3, 0x33, 0x27, 0xbb, 0x9f, 0x11, 0xa7, 0xb2, 0x53,
-	0x76, 0xef, 0x8f, 0x12, 0xdc, 0xc8, 0x07, 0xa0, 0x33, 0xa0, 0x6f, 0xbb, 0x2c, 0x03, 0x1a, 0x9e,
-	0xb6, 0x61, 0xc9, 0x6a, 0xc3, 0x53, 0x58, 0xc3, 0x78, 0xc4, 0x12, 0x1e, 0xeb, 0x72, 0x4a, 0xb7,
-	0x6c, 0x5a, 0xe4, 0xc3, 0x57, 0xa7, 0xc6, 0x3f, 0xb0, 0xd8, 0x0f, 0x62, 0x95, 0x8c, 0x83, 0x9c,
-	0x06, 0xd2, 0x03, 0x10, 0x34, 0xa1, 0x03, 0x54, 0x98, 0x64, 0x9d, 0x7d, 0xbc, 0x40, 0xcb, 0xa5,
-	0xe6, 0x4f, 0x33, 0x9d, 0x81, 0xa5, 0xbe, 0xfe, 0x0c, 0x36, 0x2e, 0xf9, 0x43, 0xd6, 0xa1, 0xdc,
-	0xc3, 0x71, 0x1a, 0xba, 0x06, 0xc9, 0x3d, 0x58, 0x32, 0x8d, 0x93, 0x56, 0xbe, 0x51, 0x10, 0x9e,
-	0xa5, 0x26, 0x98, 0x30, 0xdf, 0x2f, 0xed, 0x3a, 0xde, 0x2f, 0x0e, 0xd4, 0xac, 0x42, 0xff, 0xe3,
-	0xbc, 0xe6, 0x9b, 0xb7, 0x3c, 0xdf, 0xbc, 0xa4, 0x5b, 0x90, 0xa5, 0x87, 0x0b, 0xce, 0x7f, 0x61,
-	0x8a, 0xbc, 0x9f, 0x1c, 0x58, 0x9f, 0x6f, 0xbc, 0xa9, 0xcb, 0x8e, 0xe5, 0xf2, 0x0b, 0xa8, 0xb2,
-	0x01, 0x8d, 0xf0, 0x09, 0x8d, 0xa4, 0x5b, 0x32, 0x1e, 0x2d, 0xb2, 0xae, 0x4c, 0x6d, 0x1e, 0xa5,
-	0x4a, 0x83, 0x99, 0x7a, 0xfd, 0xfe, 0x9a, 0x43, 0x96, 0x9a, 0xf4, 0xe4, 0xfd, 0xe8, 0x00, 0xb9,
-	0x5c, 0x90, 0xc2, 0xac, 0x37, 0x00, 0x7a, 0xbb, 0xf2, 0x29, 0x26, 0xd6, 0x95, 0x6b, 0x61, 0x0a,
-	0x2f, 0xdd, 0x63, 0xa8, 0x75, 0x50, 0x2a, 0x16, 0x1b, 0x5f, 0xd3, 0x11, 0x7d, 0xff, 0xea, 0x6e,
-	0xd8, 0x9f, 0x09, 0x04, 0xb6, 0xb4, 0xf7, 0x25, 0xbc, 0x7d, 0x25, 0xb7, 0xb5, 0x64, 0x38, 0xb9,
-	0x25, 0xe3, 0xca, 0xd5, 0xc4, 0x23, 0xb0, 0x3e, 0x3f, 0xeb, 0xed, 0x5f, 0x4b, 0xb0, 0x31, 0x7b,
-	0xd5, 0xf4, 0x97, 0x85, 0x48, 0xbe, 0x80, 0xf5, 0xc3, 0x74, 0x0b, 0xcf, 0x96, 0x23, 0x72, 0xdb,
-	0x0e, 0x66, 0x6e, 0x1f, 0xaf, 0x6f, 0x15, 0x13, 0x27, 0x97, 0xa6, 0x77, 0x8d, 0x7c, 0x02, 0x2b,
-	0xe9, 0x02, 0x43, 0x72, 0x97, 0x63, 0x7e, 0xab, 0xa9, 0x6f, 0xda, 0xb4, 0x6c, 0xa9, 0xf0, 0xae,
-	0x91, 0x7d, 0x58, 0x49, 0x9f, 0xe8, 0xbc, 0x78, 0x7e, 0xa9, 0xa8, 0xdf, 0x2e, 0xa4, 0x4d, 0x9d,
-	0xf8, 0x06, 0xae, 0x1f, 0x9a, 0xdb, 0x26, 0xbd, 0xd4, 0xc9, 0x1d, 0x9b, 0xff, 0x95, 0x6f, 0x7b,
-	0xdd, 0x9b, 0x67, 0xbb, 0xfc, 0x2e, 0x78, 0xd7, 0x3e, 0xfb, 0xf4, 0xb7, 0x8b, 0x86, 0xf3, 0xfb,
-	0x45, 0xc3, 0xf9, 0xeb, 0xa2, 0xe1, 0x7c, 0xbd, 0x73, 0xd5, 0xff, 0x4f, 0xe1, 0x7f, 0xda, 0xf9,
-	0xb2, 0xf9, 0xdd, 0xb9, 0xfb, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x50, 0x2e, 0x2e, 0x03, 0xc7,
-	0x0d, 0x00, 0x00,
+	// 1122 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x17, 0x4d, 0x6f, 0x1b, 0x45,
+	0x94, 0xb5, 0x9d, 0x38, 0x7e, 0x4e, 0x9b, 0x64, 0x88, 0xda, 0x65, 0x1b, 0x42, 0xb4, 0xb4, 0x08,
+	0x04, 0xac, 0x55, 0x17, 0xa4, 0xaa, 0x12, 0x42, 0x50, 0x87, 0x34, 0x4a, 0xaa, 0xa6, 0x9b, 0xb6,
+	0x12, 0x08, 0xa9, 0x9a, 0xac, 0x27, 0xeb, 0xa9, 0xd7, 0x3b, 0xcb, 0xee, 0xda, 0x52, 0xfa, 0x07,
+	0xf8, 0x11, 0xdc, 0x90, 0x38, 0xc0, 0x05, 0xf1, 0x0b, 0xb8, 0x72, 0xe4, 0x88, 0x38, 0x21, 0x7e,
+	0x09, 0x33, 0xb3, 0x5f, 0xb3, 0xeb, 0xad, 0x85, 0x64, 0x41, 0x7b, 0x88, 0x33, 0xf3, 0xbe, 0x3f,
+	0xe7, 0xbd, 0x85, 0x77, 0x42, 0x12, 0xb0, 0x88, 0x84, 0x33, 0x12, 0xf6, 0xe4, 0x91, 0xc6, 0x2c,
+	0xbc, 0x50, 0x8e, 0x56, 0x10, 0xb2, 0x98, 0x21, 0x28, 0x20, 0xc6, 0xb6, 0xcb, 0x5c, 0x26, 0xc1,
+	0x3d, 0x71, 0x4a, 0x28, 0x8c, 0x1d, 0x97, 0x31, 0xd7, 0x23, 0x3d, 0x1c, 0xd0, 0x1e, 0xf6, 0x7d,
+	0x16, 0xe3, 0x98, 0x32, 0x3f, 0x4a, 0xb1, 0xe6, 0xf8, 0x76, 0x64, 0x51, 0x26, 0xb1, 0x0e, 0x0b,
+	0x49, 0x6f, 0x76, 0xb3, 0xe7, 0x12, 0x9f, 0x84, 0x38, 0x26, 0xc3, 0x94, 0xe6, 0xd0, 0xa5, 0xf1,
+	0x68, 0x7a, 0x66, 0x39, 0x6c, 0xd2, 0xc3, 0xa1, 0x54, 0xf1, 0x4c, 0x1e, 0x3e, 0x74, 0x86, 0xbd,
+	0x60, 0xec, 0x0a, 0xe6, 0x88, 0xff, 0x04, 0x1e, 0x75, 0xa4, 0x70, 0x2e, 0x04, 0x7b, 0xc1, 0x08,
+	0xcf, 0x89, 0x32, 0xff, 0x6c, 0xc1, 0xc6, 0x7d, 0xec, 0xd3, 0x73, 0x12, 0xc5, 0x36, 0xf9, 0x66,
+	0xca, 0xff, 0xa1, 0x2f, 0xa1, 0x25, 0x9c, 0xd0, 0xb5, 0x3d, 0xed, 0xdd, 0x6e, 0x7f, 0xdf, 0x2a,
+	0xb4, 0x59, 0x99, 0x36, 0x79, 0x78, 0xea, 0x70, 0x29, 0x63, 0xd7, 0x12, 0xda, 0x2c, 0x45, 0x9b,
+	0x95, 0x69, 0xb3, 0xec, 0x3c, 0x16, 0xb6, 0x14, 0x89, 0x0c, 0x58, 0x0b, 0xc9, 0x8c, 0x46, 0x9c,
+	0x4a, 0x6f, 0x70, 0xf1, 0x1d, 0x3b, 0xbf, 0x23, 0x1d, 0xda, 0x3e, 0xbb, 0x8b, 0x9d, 0x11, 0xd1,
+	0x9b, 0x1c, 0xb5, 0x66, 0x67, 0x57, 0xb4, 0x07, 0x5d, 0x2e, 0xfe, 0x18, 0x9f, 0x11, 0xef, 0x88,
+	0x5c, 0xe8, 0x2d, 0xc9, 0xa8, 0x82, 0xd0, 0x75, 0xb8, 0x94, 0x5d, 0x9f, 0x60, 0x6f, 0x4a, 0xf4,
+	0x15, 0x49, 0x53, 0x06, 0xa2, 0x1d, 0xe8, 0xf8, 0x78, 0x42, 0xa2, 0x00, 0x3b, 0x44, 0x5f, 0x93,
+	0x14, 0x05, 0x00, 0x3d, 0x87, 0x2d, 0xc5, 0x89, 0x53, 0x36, 0x0d, 0x39, 0x15, 0xc8, 0x18, 0x1c,
+	0x2f, 0x11, 0x83, 0xcf, 0xaa, 0x32, 0xed, 0x79, 0x35, 0xc8, 0x85, 0xce, 0x88, 0x78, 0x13, 0x19,
+	0x2f, 0xbd, 0xbb, 0xd7, 0xe4, 0x3a, 0x0f, 0x97, 0xd0, 0x79, 0x2f, 0x93, 0x95, 0xc4, 0xbe, 0x90,
+	0x8d, 0xc6, 0xd0, 0x0e, 0xbc, 0xa9, 0x4b, 0xfd, 0x48, 0x5f, 0x97, 0x6a, 0x1e, 0x2e, 0xa1, 0xe6,
+	0x2e, 0xf3, 0xcf, 0xa9, 0xcb, 0xcb, 0x07, 0xbb, 0x64, 0x42, 0xfc, 0xf8, 0x44, 0x4a, 0xb6, 0x33,
+	0x0d, 0xe6, 0xf7, 0x1a, 0x6c, 0x16, 0xc5, 0x15, 0x05, 0xbc, 0xca, 0x65, 0x12, 0x26, 0x29, 0x2c,
+	0xe2, 0x25, 0xd6, 0x14, 0x49, 0xc8, 0x01, 0xe5, 0x14, 0x35, 0xaa, 0x29, 0xba, 0x02, 0xab, 0x49,
+	0x0b, 0xca, 0x0a, 0xe9, 0xd8, 0xe9, 0xad, 0x54, 0x56, 0xad, 0x4a, 0x59, 0xed, 0x02, 0x44, 0x32,
+	0xc8, 0x8f, 0x2e, 0x02, 0xa2, 0xaf, 0x4a, 0xac, 0x02, 0x31, 0xbf, 0xd3, 0xe0, 0xf2, 0x31, 0x8d,
+	0xe2, 0x01, 0x0d, 0x5f, 0x72, 0x03, 0x20, 0x68, 0x05, 0x38, 0x1e, 0xa5, 0xbe, 0xc9, 0xb3, 0xb9,
+	0x07, 0x6b, 0x5f, 0x50, 0x8f, 0x08, 0x03, 0xd1, 0x36, 0xac, 0xd0, 0x98, 0x4c, 0xb2, 0xa8, 0x25,
+	0x17, 0x69, 0xff, 0x01, 0x89, 0x05, 0xd5, 0x2b, 0x68, 0xff, 0x0d, 0xd8, 0xc8, 0x8d, 0x4b, 0x0b,
+	0x80, 0x93, 0x0d, 0x71, 0x8c, 0xa5, 0x75, 0xeb, 0xb6, 0x3c, 0x9b, 0xbf, 0x34, 0xe1, 0x0d, 0xa1,
+	0xeb, 0x54, 0xe6, 0x93, 0xb7, 0xcc, 0x80, 0xc4, 0x98, 0x7a, 0xd1, 0xc3, 0x29, 0x09, 0x2f, 0x5e,
+	0x21, 0x7f, 0xca, 0x8d, 0xda, 0xfa, 0x7f, 0x1a, 0x75, 0xe5, 0xbf, 0x6e, 0x54, 0x74, 0x0b, 0x5a,
+	0x42, 0xb3, 0xec, 0x8e, 0x6e, 0xff, 0x2d, 0x4b, 0x99, 0x6a, 0xc2, 0xc2, 0x4a, 0x3e, 0x6c, 0x49,
+	0x6c, 0x7e, 0x0c, 0xaf, 0xd7, 0x20, 0x45, 0xbf, 0xcd, 0xc4, 0x6b, 0x2b, 0x72, 0x9e, 0x95, 0xaa,
+	0x02, 0x31, 0xbf, 0x6d, 0xc0, 0x15, 0xe1, 0x62, 0xc1, 0xa7, 0x56, 0x46, 0x2c, 0x9a, 0x54, 0x4b,
+	0x02, 0x2e, 0xce, 0xe8, 0x23, 0x68, 0x8f, 0x23, 0xe6, 0xfb, 0x24, 0x96, 0xf9, 0xe9, 0xf6, 0x0d,
+	0xd5, 0xba, 0xa3, 0x04, 0xc5, 0x65, 0x9d, 0x06, 0xc4, 0xb1, 0x33, 0x52, 0xf4, 0x7e, 0xea, 0x50,
+	0x53, 0xb2, 0x5c, 0xad, 0x71, 0x48, 0xd2, 0x4b, 0x22, 0x74, 0x07, 0x3a, 0xe3, 0x69, 0x14, 0xb3,
+	0x09, 0x7d, 0x4e, 0xe4, 0xf3, 0xd1, 0xed, 0xef, 0x94, 0x94, 0x64, 0xc8, 0x8c, 0xad, 0x20, 0x17,
+	0xbc, 0x43, 0x1a, 0x12, 0x47, 0x10, 0xca, 0xa1, 0x53, 0xe1, 0x1d, 0x64, 0xc8, 0x9c, 0x37, 0x27,
+	0x37, 0xff, 0x68, 0xc0, 0xe5, 0xb2, 0x03, 0x22, 0x02, 0xe2, 0xb5, 0xcb, 0x22, 0x20, 0xce, 0x79,
+	0x19, 0x36, 0x94, 0x32, 0x3c, 0x81, 0x75, 0xe2, 0xcf, 0x68, 0xc8, 0x7c, 0x91, 0xce, 0x88, 0xfb,
+	0x29, 0x4a, 0xe4, 0x83, 0x17, 0x87, 0xc6, 0xda, 0x57, 0xc8, 0xf7, 0xfd, 0x98, 0x67, 0xb1, 0x24,
+	0x81, 0xd7, 0x1b, 0x04, 0x38, 0xe4, 0xfa, 0x62, 0x12, 0x66, 0x95, 0x7d, 0xb4, 0x44, 0xc9, 0xa5,
+	0xea, 0x4f, 0x32, 0x99, 0xb6, 0x22, 0xde, 0x78, 0x0a, 0x5b, 0x73, 0xf6, 0xa0, 0x4d, 0x68, 0x8e,
+	0xf9, 0x74, 0x4f, 0x5c, 0x17, 0x47, 0x9e, 0xfb, 0x15, 0x59, 0x38, 0x69, 0xe6, 0x77, 0x6b, 0xdc,
+	0x53, 0xc4, 0xd8, 0x09, 0xf1, 0x9d, 0xc6, 0x6d, 0xcd, 0xfc, 0x55, 0x83, 0xae, 0x92, 0xe8, 0x7f,
+	0x1d, 0xd7, 0x72, 0xf1, 0x36, 0xab, 0xc5, 0x8b, 0x46, 0x35, 0x51, 0xba, 0xb7, 0x64, 0xff, 0xd7,
+	0x86, 0xc8, 0xfc, 0x89, 0xcf, 0xce, 0x6a, 0xe1, 0xe5, 0x26, 0x6b, 0x8a, 0xc9, 0xcf, 0xa0, 0x43,
+	0x27, 0xbc, 0xaf, 0x1f, 0x61, 0x37, 0xe2, 0xbe, 0x34, 0x97, 0x5c, 0x57, 0x72, 0x9d, 0x87, 0xa9,
+	0x50, 0xbb, 0x10, 0x2f, 0xe6, 0xaf, 0xbc, 0x64, 0xa1, 0x49, 0x6f, 0xe6, 0x8f, 0x1a, 0xa0, 0xf9,
+	0x84, 0xd4, 0x46, 0x9d, 0x47, 0x98, 0x6f, 0xb8, 0x4f, 0xb8, 0x8b, 0xc5, 0x93, 0xab, 0x40, 0x6a,
+	0x1f, 0xdd, 0x23, 0xe8, 0x0e, 0xf9, 0x5c, 0xa3, 0xbe, 0xb4, 0x35, 0x6d, 0xd1, 0xf7, 0x16, 0x57,
+	0xc3, 0xa0, 0x60, 0xb0, 0x55, 0x6e, 0xf3, 0x31, 0xbc, 0xb9, 0x90, 0x5a, 0x59, 0x32, 0xb4, 0xd2,
+	0x92, 0xb1, 0x70, 0x35, 0x31, 0x11, 0x6c, 0x56, 0x7b, 0xdd, 0xfc, 0x59, 0x13, 0x4f, 0x5d, 0xc4,
+	0xbc, 0x19, 0x9f, 0x7e, 0xc9, 0x50, 0x79, 0xc9, 0x23, 0x9a, 0x47, 0x1f, 0xfb, 0x0e, 0x37, 0x80,
+	0x85, 0x0f, 0xce, 0xd3, 0x18, 0x2b, 0x10, 0xfe, 0xa6, 0x5f, 0x9d, 0x33, 0x38, 0x7d, 0x9c, 0x55,
+	0xb1, 0x5a, 0x59, 0x6c, 0xff, 0x87, 0x26, 0x6c, 0x15, 0xe3, 0x5b, 0xfc, 0x52, 0xbe, 0xad, 0x3d,
+	0x80, 0xcd, 0x83, 0xf4, 0x73, 0x23, 0xdb, 0x02, 0xd1, 0x35, 0x35, 0x6b, 0x95, 0x0f, 0x0f, 0x63,
+	0xa7, 0x1e, 0x99, 0x18, 0x60, 0xbe, 0x86, 0x3e, 0x81, 0x76, 0xba, 0xa9, 0xa1, 0xd2, 0x14, 0x28,
+	0xaf, 0x6f, 0xc6, 0xb6, 0x8a, 0xcb, 0xb6, 0x27, 0xce, 0x3e, 0x80, 0x76, 0xba, 0x8b, 0x94, 0xd9,
+	0xcb, 0xdb, 0x93, 0x71, 0xad, 0x16, 0x97, 0x1b, 0xf1, 0x35, 0x5c, 0x3a, 0x90, 0xcf, 0x6a, 0x3a,
+	0xbd, 0xd0, 0x0d, 0x95, 0xfe, 0x85, 0x4b, 0x8c, 0x61, 0x56, 0xc9, 0xe6, 0x07, 0xa0, 0x94, 0xbe,
+	0x51, 0x49, 0x00, 0xaa, 0x30, 0xd6, 0x95, 0x93, 0xf1, 0xf6, 0x42, 0x9a, 0x4c, 0xfa, 0xe7, 0x9f,
+	0xfe, 0xf6, 0xf7, 0xae, 0xf6, 0x3b, 0xff, 0xfb, 0x8b, 0xff, 0x7d, 0x75, 0x73, 0xd1, 0x67, 0x64,
+	0xed, 0xe7, 0xee, 0xd9, 0xaa, 0xfc, 0x6a, 0xbc, 0xf5, 0x0f, 0x68, 0xfa, 0xf7, 0x6c, 0x0e, 0x0f,
+	0x00, 0x00,
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	argoutil "github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/rbac"
@@ -161,17 +163,22 @@ func (s *Server) GetManifests(ctx context.Context, q *ApplicationManifestQuery)
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
 		return nil, err
 	}
-	repo := s.getRepo(ctx, a.Spec.Source.RepoURL)
+	revision := a.Spec.Source.TargetRevision
+	if q.Revision != "" {
+		revision = q.Revision
+	}
+	return s.generateManifests(ctx, a, a.Spec.Source, revision)
+}
+
+// generateManifests renders the manifests of the application from the given source at the given revision
+func (s *Server) generateManifests(ctx context.Context, a *appv1.Application, source appv1.ApplicationSource, revision string) (*repository.ManifestResponse, error) {
+	repo := s.getRepo(ctx, source.RepoURL)
 
 	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
 	if err != nil {
 		return nil, err
 	}
 	defer util.Close(conn)
-	revision := a.Spec.Source.TargetRevision
-	if q.Revision != "" {
-		revision = q.Revision
-	}
 	settings, err := s.settingsMgr.GetSettings()
 	if err != nil {
 		return nil, err
@@ -184,21 +191,16 @@ func (s *Server) GetManifests(ctx context.Context, q *ApplicationManifestQuery)
 	for i := range settings.ConfigManagementPlugins {
 		tools[i] = &settings.ConfigManagementPlugins[i]
 	}
-	manifestInfo, err := repoClient.GenerateManifest(ctx, &repository.ManifestRequest{
+	return repoClient.GenerateManifest(ctx, &repository.ManifestRequest{
 		Repo:              repo,
 		Revision:          revision,
 		AppLabelKey:       settings.GetAppInstanceLabelKey(),
 		AppLabelValue:     a.Name,
 		Namespace:         a.Spec.Destination.Namespace,
-		ApplicationSource: &a.Spec.Source,
+		ApplicationSource: &source,
 		HelmRepos:         helmRepos,
 		Plugins:           tools,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	return manifestInfo, nil
 }
 
 // Get returns an application by name
@@ -390,15 +392,31 @@ func (s *Server) Delete(ctx context.Context, q *ApplicationDeleteRequest) (*Appl
 
 	patchFinalizer := false
 	if q.Cascade == nil || *q.Cascade {
-		if !a.CascadedDeletion() {
-			a.SetCascadedDeletion(true)
+		policy := metav1.DeletePropagationForeground
+		if q.PropagationPolicy != nil {
+			policy, err = appv1.ParseDeletionPropagationPolicy(*q.PropagationPolicy)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+		if !a.CascadedDeletion() || a.DeletionPropagationPolicy() != policy {
+			a.SetDeletionPropagationPolicy(policy)
 			patchFinalizer = true
 		}
 	} else {
+		if q.PropagationPolicy != nil {
+			return nil, status.Error(codes.InvalidArgument, "propagation policy can only be specified for a cascading delete")
+		}
 		if a.CascadedDeletion() {
 			a.SetCascadedDeletion(false)
 			patchFinalizer = true
 		}
+		// The resources are orphaned, so they must no longer be recognized as part of the app, e.g.
+		// when they are handed over to another app
+		err = s.removeAppInstanceLabels(a)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if patchFinalizer {
@@ -521,6 +539,56 @@ func (s *Server) getApplicationClusterConfig(applicationName string) (*rest.Conf
 	return config, namespace, err
 }
 
+// removeAppInstanceLabels removes the app instance label from the live resources managed by the app
+func (s *Server) removeAppInstanceLabels(a *appv1.Application) error {
+	items, err := s.cache.GetAppManagedResources(a.Name)
+	if err == cache.ErrCacheMiss {
+		log.Warnf("Unable to remove app instance labels from the resources of '%s': managed resources are unknown", a.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	argoSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return err
+	}
+	appLabelKey := argoSettings.GetAppInstanceLabelKey()
+	clst, err := s.db.GetCluster(context.Background(), a.Spec.Destination.Server)
+	if err != nil {
+		return err
+	}
+	config := clst.RESTConfig()
+	for _, item := range items {
+		liveObj, err := item.LiveObject()
+		if err != nil {
+			return err
+		}
+		if liveObj == nil || kube.GetAppInstanceLabel(liveObj, appLabelKey) != a.Name {
+			continue
+		}
+		unlabeledObj := liveObj.DeepCopy()
+		kube.UnsetLabel(unlabeledObj, appLabelKey)
+		liveBytes, err := json.Marshal(liveObj)
+		if err != nil {
+			return err
+		}
+		unlabeledBytes, err := json.Marshal(unlabeledObj)
+		if err != nil {
+			return err
+		}
+		patch, err := jsonpatch.CreateMergePatch(liveBytes, unlabeledBytes)
+		if err != nil {
+			return err
+		}
+		_, err = s.kubectl.PatchResource(config, liveObj.GroupVersionKind(), liveObj.GetName(), liveObj.GetNamespace(), types.MergePatchType, patch)
+		if err != nil && !apierr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Server) getAppResources(ctx context.Context, q *ResourcesQuery) (*appv1.ApplicationTree, error) {
 	return s.cache.GetAppResourcesTree(*q.ApplicationName)
 }
@@ -643,7 +711,13 @@ func (s *Server) DeleteResource(ctx context.Context, q *ApplicationResourceDelet
 	if q.Force != nil {
 		force = *q.Force
 	}
-	err = s.kubectl.DeleteResource(config, res.GroupKindVersion(), res.Name, res.Namespace, force)
+	deleteOptions := kube.NewDeleteOptions(metav1.DeletePropagationForeground)
+	if force {
+		deleteOptions = kube.NewDeleteOptions(metav1.DeletePropagationBackground)
+		zeroGracePeriod := int64(0)
+		deleteOptions.GracePeriodSeconds = &zeroGracePeriod
+	}
+	err = s.kubectl.DeleteResource(config, res.GroupKindVersion(), res.Name, res.Namespace, deleteOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -789,6 +863,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *ApplicationSyncRequest) (*ap
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	if err := s.checkSyncWindows(a); err != nil {
+		return nil, err
+	}
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
 		if syncReq.Revision != "" && syncReq.Revision != a.Spec.Source.TargetRevision {
 			return nil, status.Errorf(codes.FailedPrecondition, "Cannot sync to %s: auto-sync currently set to %s", syncReq.Revision, a.Spec.Source.TargetRevision)
@@ -800,6 +877,26 @@ func (s *Server) Sync(ctx context.Context, syncReq *ApplicationSyncRequest) (*ap
 		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
 	}
 
+	retry := syncReq.RetryStrategy
+	if retry == nil && a.Spec.SyncPolicy != nil {
+		retry = a.Spec.SyncPolicy.Retry
+	}
+	if retry != nil {
+		if err := retry.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	healthCheck := syncReq.HealthCheck
+	if healthCheck == nil && a.Spec.SyncPolicy != nil {
+		healthCheck = a.Spec.SyncPolicy.HealthCheck
+	}
+	if healthCheck != nil {
+		if _, err := healthCheck.GetTimeout(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
 			Revision:     commitSHA,
@@ -807,19 +904,50 @@ func (s *Server) Sync(ctx context.Context, syncReq *ApplicationSyncRequest) (*ap
 			DryRun:       syncReq.DryRun,
 			SyncStrategy: syncReq.Strategy,
 			Resources:    syncReq.Resources,
+			Retry:        retry,
+			HealthCheck:  healthCheck,
+			Timeout:      syncReq.Timeout,
 		},
+		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 	}
-	a, err = argo.SetAppOperation(appIf, *syncReq.Name, &op)
-	if err == nil {
-		partial := ""
-		if len(syncReq.Resources) > 0 {
-			partial = "partial "
+	if _, err := op.Sync.GetTimeout(0); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	partial := ""
+	if len(syncReq.Resources) > 0 {
+		partial = "partial "
+	}
+	if syncReq.Queue {
+		var pending *appv1.PendingOperation
+		a, pending, err = argo.QueueAppOperation(appIf, *syncReq.Name, &op)
+		if err == nil && pending != nil {
+			s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("queued %ssync to %s (%s)", partial, displayRevision, pending.ID))
+			return a, nil
 		}
+	} else {
+		a, err = argo.SetAppOperation(appIf, *syncReq.Name, &op)
+	}
+	if err == nil {
 		s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated %ssync to %s", partial, displayRevision))
 	}
 	return a, err
 }
 
+// checkSyncWindows returns an error if the sync windows of the application's project deny a manual sync
+func (s *Server) checkSyncWindows(a *appv1.Application) error {
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(a.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return status.Errorf(codes.InvalidArgument, "application references project %s which does not exist", a.Spec.Project)
+		}
+		return err
+	}
+	if canSync, message := proj.CanSync(a, true, time.Now()); !canSync {
+		return status.Errorf(codes.PermissionDenied, "Cannot sync: %s", message)
+	}
+	return nil
+}
+
 func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackRequest) (*appv1.Application, error) {
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
 	a, err := appIf.Get(*rollbackReq.Name, metav1.GetOptions{})
@@ -835,40 +963,188 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackR
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "rollback cannot be initiated when auto-sync is enabled")
 	}
+	if err := s.checkSyncWindows(a); err != nil {
+		return nil, err
+	}
 
-	var deploymentInfo *appv1.RevisionHistory
-	for _, info := range a.Status.History {
-		if info.ID == rollbackReq.ID {
-			deploymentInfo = &info
-			break
+	var revision string
+	var source appv1.ApplicationSource
+	var target string
+	if rollbackReq.Revision != "" {
+		revision, err = s.resolveRollbackRevision(ctx, a, rollbackReq.Revision)
+		if err != nil {
+			return nil, err
 		}
-	}
-	if deploymentInfo == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.Name, rollbackReq.ID)
-	}
-	if deploymentInfo.Source.IsZero() {
-		// Since source type was introduced to history starting with v0.12, and is now required for
-		// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
-		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
+		source = a.Spec.Source
+		target = revision
+	} else {
+		deploymentInfo, err := getDeploymentInfo(a, rollbackReq.ID)
+		if err != nil {
+			return nil, err
+		}
+		revision = deploymentInfo.Revision
+		source = deploymentInfo.Source
+		target = fmt.Sprintf("%d", rollbackReq.ID)
 	}
 
 	// Rollback is just a convenience around Sync
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision:     deploymentInfo.Revision,
+			Revision:     revision,
 			DryRun:       rollbackReq.DryRun,
 			Prune:        rollbackReq.Prune,
 			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
-			Source:       &deploymentInfo.Source,
+			Source:       &source,
 		},
+		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 	}
 	a, err = argo.SetAppOperation(appIf, *rollbackReq.Name, &op)
 	if err == nil {
-		s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %d", rollbackReq.ID))
+		s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %s", target))
 	}
 	return a, err
 }
 
+// resolveRollbackRevision resolves the revision to roll back to into a commit SHA using the repo
+// server, which also verifies the commit is reachable from the target revision of the application
+func (s *Server) resolveRollbackRevision(ctx context.Context, a *appv1.Application, revision string) (string, error) {
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return "", err
+	}
+	defer util.Close(conn)
+	res, err := repoClient.ResolveRevision(ctx, &repository.ResolveRevisionRequest{
+		Repo:       s.getRepo(ctx, a.Spec.Source.RepoURL),
+		Revision:   revision,
+		AncestorOf: util.FirstNonEmpty(a.Spec.Source.TargetRevision, "HEAD"),
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.Revision, nil
+}
+
+// getDeploymentInfo returns the deployment with the given id from the application's history
+func getDeploymentInfo(a *appv1.Application, id int64) (*appv1.RevisionHistory, error) {
+	for i := range a.Status.History {
+		if a.Status.History[i].ID == id {
+			deploymentInfo := a.Status.History[i]
+			if deploymentInfo.Source.IsZero() {
+				// Since source type was introduced to history starting with v0.12, and is now required for
+				// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
+				return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
+			}
+			return &deploymentInfo, nil
+		}
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.Name, id)
+}
+
+// HistoryDiff renders the manifests of two deployments of the application history and returns the
+// difference between them. The manifests of the first deployment are returned as live state and the
+// manifests of the second deployment as target state.
+func (s *Server) HistoryDiff(ctx context.Context, q *ApplicationHistoryDiffQuery) (*ManagedResourcesResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
+		return nil, err
+	}
+	from, err := getDeploymentInfo(a, q.FromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := getDeploymentInfo(a, q.ToID)
+	if err != nil {
+		return nil, err
+	}
+	fromObjs, err := s.deploymentObjects(ctx, a, from)
+	if err != nil {
+		return nil, err
+	}
+	toObjs, err := s.deploymentObjects(ctx, a, to)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]kube.ResourceKey, 0)
+	for key := range fromObjs {
+		keys = append(keys, key)
+	}
+	for key := range toObjs {
+		if _, ok := fromObjs[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	items := make([]*appv1.ResourceDiff, 0)
+	for _, key := range keys {
+		live := fromObjs[key]
+		target := toObjs[key]
+		if key.Kind == kube.SecretKind && key.Group == "" {
+			target, live, err = diff.HideSecretData(target, live)
+			if err != nil {
+				return nil, err
+			}
+		}
+		jsonDiff, err := diff.Diff(target, live, nil).JSONFormat()
+		if err != nil {
+			return nil, err
+		}
+		item := appv1.ResourceDiff{
+			Group:     key.Group,
+			Kind:      key.Kind,
+			Namespace: key.Namespace,
+			Name:      key.Name,
+			Diff:      jsonDiff,
+		}
+		if item.LiveState, err = marshalState(live); err != nil {
+			return nil, err
+		}
+		if item.TargetState, err = marshalState(target); err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+	return &ManagedResourcesResponse{Items: items}, nil
+}
+
+// deploymentObjects renders the manifests of a deployment of the application history
+func (s *Server) deploymentObjects(ctx context.Context, a *appv1.Application, deploymentInfo *appv1.RevisionHistory) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	manifestInfo, err := s.generateManifests(ctx, a, deploymentInfo.Source, deploymentInfo.Revision)
+	if err != nil {
+		return nil, err
+	}
+	objs := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, manifest := range manifestInfo.Manifests {
+		obj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			continue
+		}
+		objs[kube.GetResourceKey(obj)] = obj
+	}
+	return objs, nil
+}
+
+// marshalState returns the JSON representation of the object, or "null" if there is none
+func marshalState(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "null", nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 // resolveRevision resolves the git revision specified either in the sync request, or the
 // application source, into a concrete commit SHA that will be used for a sync operation.
 func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, syncReq *ApplicationSyncRequest) (string, string, error) {
@@ -929,6 +1205,24 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *OperationTer
 	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
 }
 
+// CancelPendingOperation removes an operation from the queue of pending operations
+func (s *Server) CancelPendingOperation(ctx context.Context, q *PendingOperationCancelRequest) (*appv1.Application, error) {
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
+	a, err := appIf.Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, appRBACName(*a)); err != nil {
+		return nil, err
+	}
+	a, err = argo.CancelPendingOperation(appIf, *q.Name, q.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(a, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("cancelled pending operation %s", q.ID))
+	return a, nil
+}
+
 func (s *Server) logEvent(a *appv1.Application, ctx context.Context, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: v1.EventTypeNormal, Reason: reason}
 	user := session.Username(ctx)
@@ -373,6 +373,7 @@ func (m *ApplicationUpdateRequest) GetApplication() *v1alpha1.Application {
 type ApplicationDeleteRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Cascade              *bool    `protobuf:"varint,2,opt,name=cascade" json:"cascade,omitempty"`
+	PropagationPolicy    *string  `protobuf:"bytes,3,opt,name=propagationPolicy" json:"propagationPolicy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -425,6 +426,13 @@ func (m *ApplicationDeleteRequest) GetCascade() bool {
 	return false
 }
 
+func (m *ApplicationDeleteRequest) GetPropagationPolicy() string {
+	if m != nil && m.PropagationPolicy != nil {
+		return *m.PropagationPolicy
+	}
+	return ""
+}
+
 // ApplicationSyncRequest is a request to apply the config state to live state
 type ApplicationSyncRequest struct {
 	Name                 *string                          `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -433,6 +441,10 @@ type ApplicationSyncRequest struct {
 	Prune                bool                             `protobuf:"varint,4,opt,name=prune" json:"prune"`
 	Strategy             *v1alpha1.SyncStrategy           `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
 	Resources            []v1alpha1.SyncOperationResource `protobuf:"bytes,7,rep,name=resources" json:"resources"`
+	RetryStrategy        *v1alpha1.RetryStrategy          `protobuf:"bytes,8,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
+	HealthCheck          *v1alpha1.SyncHealthCheck        `protobuf:"bytes,9,opt,name=healthCheck" json:"healthCheck,omitempty"`
+	Timeout              string                           `protobuf:"bytes,10,opt,name=timeout" json:"timeout"`
+	Queue                bool                             `protobuf:"varint,11,opt,name=queue" json:"queue"`
 	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
 	XXX_unrecognized     []byte                           `json:"-"`
 	XXX_sizecache        int32                            `json:"-"`
@@ -513,6 +525,34 @@ func (m *ApplicationSyncRequest) GetResources() []v1alpha1.SyncOperationResource
 	return nil
 }
 
+func (m *ApplicationSyncRequest) GetRetryStrategy() *v1alpha1.RetryStrategy {
+	if m != nil {
+		return m.RetryStrategy
+	}
+	return nil
+}
+
+func (m *ApplicationSyncRequest) GetHealthCheck() *v1alpha1.SyncHealthCheck {
+	if m != nil {
+		return m.HealthCheck
+	}
+	return nil
+}
+
+func (m *ApplicationSyncRequest) GetTimeout() string {
+	if m != nil {
+		return m.Timeout
+	}
+	return ""
+}
+
+func (m *ApplicationSyncRequest) GetQueue() bool {
+	if m != nil {
+		return m.Queue
+	}
+	return false
+}
+
 // ApplicationUpdateSpecRequest is a request to update application spec
 type ApplicationUpdateSpecRequest struct {
 	Name                 *string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -630,6 +670,7 @@ type ApplicationRollbackRequest struct {
 	ID                   int64    `protobuf:"varint,2,req,name=id" json:"id"`
 	DryRun               bool     `protobuf:"varint,3,opt,name=dryRun" json:"dryRun"`
 	Prune                bool     `protobuf:"varint,4,opt,name=prune" json:"prune"`
+	Revision             string   `protobuf:"bytes,5,opt,name=revision" json:"revision"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -696,6 +737,13 @@ func (m *ApplicationRollbackRequest) GetPrune() bool {
 	return false
 }
 
+func (m *ApplicationRollbackRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type ApplicationResourceRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
@@ -1366,6 +1414,126 @@ func (m *ManagedResourcesResponse) GetItems() []*v1alpha1.ResourceDiff {
 	return nil
 }
 
+// ApplicationHistoryDiffQuery is a query for the difference between two deployments of the application history
+type ApplicationHistoryDiffQuery struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	FromID               int64    `protobuf:"varint,2,opt,name=fromID" json:"fromID"`
+	ToID                 int64    `protobuf:"varint,3,opt,name=toID" json:"toID"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationHistoryDiffQuery) Reset()         { *m = ApplicationHistoryDiffQuery{} }
+func (m *ApplicationHistoryDiffQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationHistoryDiffQuery) ProtoMessage()    {}
+func (*ApplicationHistoryDiffQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_66b618849375abb2, []int{21}
+}
+func (m *ApplicationHistoryDiffQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationHistoryDiffQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationHistoryDiffQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationHistoryDiffQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHistoryDiffQuery.Merge(dst, src)
+}
+func (m *ApplicationHistoryDiffQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationHistoryDiffQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHistoryDiffQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHistoryDiffQuery proto.InternalMessageInfo
+
+func (m *ApplicationHistoryDiffQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationHistoryDiffQuery) GetFromID() int64 {
+	if m != nil {
+		return m.FromID
+	}
+	return 0
+}
+
+func (m *ApplicationHistoryDiffQuery) GetToID() int64 {
+	if m != nil {
+		return m.ToID
+	}
+	return 0
+}
+
+// PendingOperationCancelRequest is a request to remove an operation from the queue of pending operations
+type PendingOperationCancelRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	ID                   string   `protobuf:"bytes,2,opt,name=id" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingOperationCancelRequest) Reset()         { *m = PendingOperationCancelRequest{} }
+func (m *PendingOperationCancelRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingOperationCancelRequest) ProtoMessage()    {}
+func (*PendingOperationCancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_66b618849375abb2, []int{22}
+}
+func (m *PendingOperationCancelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingOperationCancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingOperationCancelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *PendingOperationCancelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOperationCancelRequest.Merge(dst, src)
+}
+func (m *PendingOperationCancelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingOperationCancelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOperationCancelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOperationCancelRequest proto.InternalMessageInfo
+
+func (m *PendingOperationCancelRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *PendingOperationCancelRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ApplicationQuery)(nil), "application.ApplicationQuery")
 	proto.RegisterType((*ApplicationResourceEventsQuery)(nil), "application.ApplicationResourceEventsQuery")
@@ -1388,6 +1556,8 @@ func init() {
 	proto.RegisterType((*OperationTerminateResponse)(nil), "application.OperationTerminateResponse")
 	proto.RegisterType((*ResourcesQuery)(nil), "application.ResourcesQuery")
 	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
+	proto.RegisterType((*ApplicationHistoryDiffQuery)(nil), "application.ApplicationHistoryDiffQuery")
+	proto.RegisterType((*PendingOperationCancelRequest)(nil), "application.PendingOperationCancelRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1427,8 +1597,12 @@ type ApplicationServiceClient interface {
 	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
 	// Rollback syncs an application to its target state
 	Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// HistoryDiff returns the difference between the manifests of two deployments of the application history
+	HistoryDiff(ctx context.Context, in *ApplicationHistoryDiffQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
 	// TerminateOperation terminates the currently running operation
 	TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error)
+	// CancelPendingOperation removes an operation from the queue of pending operations
+	CancelPendingOperation(ctx context.Context, in *PendingOperationCancelRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// GetResource returns single application resource
 	GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
 	// PatchResource patch single application resource
@@ -1596,6 +1770,15 @@ func (c *applicationServiceClient) Rollback(ctx context.Context, in *Application
 	return out, nil
 }
 
+func (c *applicationServiceClient) HistoryDiff(ctx context.Context, in *ApplicationHistoryDiffQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error) {
+	out := new(ManagedResourcesResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/HistoryDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error) {
 	out := new(OperationTerminateResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/TerminateOperation", in, out, opts...)
@@ -1605,6 +1788,15 @@ func (c *applicationServiceClient) TerminateOperation(ctx context.Context, in *O
 	return out, nil
 }
 
+func (c *applicationServiceClient) CancelPendingOperation(ctx context.Context, in *PendingOperationCancelRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/CancelPendingOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
 	out := new(ApplicationResourceResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetResource", in, out, opts...)
@@ -1693,8 +1885,12 @@ type ApplicationServiceServer interface {
 	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
 	// Rollback syncs an application to its target state
 	Rollback(context.Context, *ApplicationRollbackRequest) (*v1alpha1.Application, error)
+	// HistoryDiff returns the difference between the manifests of two deployments of the application history
+	HistoryDiff(context.Context, *ApplicationHistoryDiffQuery) (*ManagedResourcesResponse, error)
 	// TerminateOperation terminates the currently running operation
 	TerminateOperation(context.Context, *OperationTerminateRequest) (*OperationTerminateResponse, error)
+	// CancelPendingOperation removes an operation from the queue of pending operations
+	CancelPendingOperation(context.Context, *PendingOperationCancelRequest) (*v1alpha1.Application, error)
 	// GetResource returns single application resource
 	GetResource(context.Context, *ApplicationResourceRequest) (*ApplicationResourceResponse, error)
 	// PatchResource patch single application resource
@@ -1964,6 +2160,24 @@ func _ApplicationService_Rollback_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_HistoryDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationHistoryDiffQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).HistoryDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/HistoryDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).HistoryDiff(ctx, req.(*ApplicationHistoryDiffQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_TerminateOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OperationTerminateRequest)
 	if err := dec(in); err != nil {
@@ -1982,6 +2196,24 @@ func _ApplicationService_TerminateOperation_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_CancelPendingOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingOperationCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CancelPendingOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/CancelPendingOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CancelPendingOperation(ctx, req.(*PendingOperationCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationResourceRequest)
 	if err := dec(in); err != nil {
@@ -2113,10 +2345,18 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Rollback",
 			Handler:    _ApplicationService_Rollback_Handler,
 		},
+		{
+			MethodName: "HistoryDiff",
+			Handler:    _ApplicationService_HistoryDiff_Handler,
+		},
 		{
 			MethodName: "TerminateOperation",
 			Handler:    _ApplicationService_TerminateOperation_Handler,
 		},
+		{
+			MethodName: "CancelPendingOperation",
+			Handler:    _ApplicationService_CancelPendingOperation_Handler,
+		},
 		{
 			MethodName: "GetResource",
 			Handler:    _ApplicationService_GetResource_Handler,
@@ -2393,6 +2633,12 @@ func (m *ApplicationDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.PropagationPolicy != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PropagationPolicy)))
+		i += copy(dAtA[i:], *m.PropagationPolicy)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2464,6 +2710,38 @@ func (m *ApplicationSyncRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.RetryStrategy != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.RetryStrategy.Size()))
+		n4, err := m.RetryStrategy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.HealthCheck != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.HealthCheck.Size()))
+		n5, err := m.HealthCheck.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Timeout)))
+	i += copy(dAtA[i:], m.Timeout)
+	dAtA[i] = 0x58
+	i++
+	if m.Queue {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2496,11 +2774,11 @@ func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.Spec.Size()))
-	n4, err := m.Spec.MarshalTo(dAtA[i:])
+	n6, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n6
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2582,6 +2860,10 @@ func (m *ApplicationRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0
 	}
 	i++
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2824,11 +3106,11 @@ func (m *ApplicationPodLogsQuery) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintApplication(dAtA, i, uint64(m.SinceTime.Size()))
-		n5, err := m.SinceTime.MarshalTo(dAtA[i:])
+		n7, err := m.SinceTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	dAtA[i] = 0x38
 	i++
@@ -2869,11 +3151,11 @@ func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.TimeStamp.Size()))
-	n6, err := m.TimeStamp.MarshalTo(dAtA[i:])
+	n8, err := m.TimeStamp.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n8
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2992,6 +3274,74 @@ func (m *ManagedResourcesResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ApplicationHistoryDiffQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationHistoryDiffQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.FromID))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.ToID))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PendingOperationCancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingOperationCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ID)))
+	i += copy(dAtA[i:], m.ID)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -3104,6 +3454,10 @@ func (m *ApplicationDeleteRequest) Size() (n int) {
 	if m.Cascade != nil {
 		n += 2
 	}
+	if m.PropagationPolicy != nil {
+		l = len(*m.PropagationPolicy)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3131,6 +3485,17 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.HealthCheck != nil {
+		l = m.HealthCheck.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Timeout)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3177,6 +3542,8 @@ func (m *ApplicationRollbackRequest) Size() (n int) {
 	n += 1 + sovApplication(uint64(m.ID))
 	n += 2
 	n += 2
+	l = len(m.Revision)
+	n += 1 + l + sovApplication(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3361,12 +3728,42 @@ func (m *ManagedResourcesResponse) Size() (n int) {
 	return n
 }
 
-func sovApplication(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *ApplicationHistoryDiffQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	n += 1 + sovApplication(uint64(m.FromID))
+	n += 1 + sovApplication(uint64(m.ToID))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PendingOperationCancelRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.ID)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovApplication(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
 		}
 	}
 	return n
@@ -4143,6 +4540,36 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.Cascade = &b
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropagationPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.PropagationPolicy = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4362,6 +4789,121 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &v1alpha1.RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HealthCheck == nil {
+				m.HealthCheck = &v1alpha1.SyncHealthCheck{}
+			}
+			if err := m.HealthCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queue = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4747,6 +5289,35 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Prune = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -6413,6 +6984,245 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationHistoryDiffQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationHistoryDiffQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationHistoryDiffQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromID", wireType)
+			}
+			m.FromID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToID", wireType)
+			}
+			m.ToID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingOperationCancelRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingOperationCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingOperationCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApplication(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6523,110 +7333,124 @@ func init() {
 }
 
 var fileDescriptor_application_66b618849375abb2 = []byte{
-	// 1666 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1c, 0xc5,
-	0x12, 0x7f, 0xbd, 0xbb, 0xf6, 0xda, 0x65, 0xbf, 0xbc, 0xbc, 0x7e, 0xf9, 0x98, 0x37, 0x71, 0xec,
-	0x55, 0xc7, 0x71, 0x1c, 0x27, 0x9e, 0x89, 0x4d, 0x04, 0x91, 0x15, 0x29, 0xc1, 0x24, 0x38, 0x46,
-	0x8e, 0x31, 0x6b, 0x47, 0x48, 0x48, 0x08, 0x4d, 0x66, 0xda, 0xbb, 0x83, 0x77, 0xa7, 0x87, 0x99,
-	0xd9, 0x45, 0x4b, 0x94, 0x03, 0x11, 0xe2, 0x84, 0x88, 0x10, 0x1c, 0x82, 0x04, 0x02, 0xe5, 0xc0,
-	0x89, 0x1b, 0xe2, 0xc2, 0x81, 0x1b, 0x52, 0x8e, 0x48, 0x70, 0x8e, 0x90, 0xe1, 0x6f, 0xe0, 0x8c,
-	0xba, 0xe7, 0xab, 0xc7, 0xde, 0x9d, 0x75, 0xc8, 0x72, 0xc8, 0xad, 0xa7, 0xba, 0xba, 0xea, 0xd7,
-	0xd5, 0xbf, 0xae, 0xae, 0xda, 0x85, 0x69, 0x9f, 0x7a, 0x6d, 0xea, 0xe9, 0x86, 0xeb, 0x36, 0x6c,
-	0xd3, 0x08, 0x6c, 0xe6, 0xc8, 0x63, 0xcd, 0xf5, 0x58, 0xc0, 0xf0, 0x98, 0x24, 0x52, 0x8f, 0xd4,
-	0x58, 0x8d, 0x09, 0xb9, 0xce, 0x47, 0xa1, 0x8a, 0x3a, 0x51, 0x63, 0xac, 0xd6, 0xa0, 0xba, 0xe1,
-	0xda, 0xba, 0xe1, 0x38, 0x2c, 0x10, 0xca, 0x7e, 0x34, 0x4b, 0x76, 0x2e, 0xf9, 0x9a, 0xcd, 0xc4,
-	0xac, 0xc9, 0x3c, 0xaa, 0xb7, 0x17, 0xf4, 0x1a, 0x75, 0xa8, 0x67, 0x04, 0xd4, 0x8a, 0x74, 0x2e,
-	0xa6, 0x3a, 0x4d, 0xc3, 0xac, 0xdb, 0x0e, 0xf5, 0x3a, 0xba, 0xbb, 0x53, 0xe3, 0x02, 0x5f, 0x6f,
-	0xd2, 0xc0, 0xe8, 0xb6, 0x6a, 0xb5, 0x66, 0x07, 0xf5, 0xd6, 0x6d, 0xcd, 0x64, 0x4d, 0xdd, 0xf0,
-	0x04, 0xb0, 0xb7, 0xc5, 0x60, 0xde, 0xb4, 0xd2, 0xd5, 0xf2, 0xf6, 0xda, 0x0b, 0x46, 0xc3, 0xad,
-	0x1b, 0xfb, 0x4d, 0x2d, 0xe7, 0x99, 0xf2, 0xa8, 0xcb, 0xa2, 0x58, 0x89, 0xa1, 0x1d, 0x30, 0xaf,
-	0x23, 0x0d, 0x43, 0x1b, 0xa4, 0x0e, 0x87, 0x5f, 0x4c, 0x7d, 0xbd, 0xd6, 0xa2, 0x5e, 0x07, 0x63,
-	0x28, 0x39, 0x46, 0x93, 0x2a, 0xa8, 0x82, 0x66, 0x47, 0xab, 0x62, 0x8c, 0x15, 0x28, 0x7b, 0x74,
-	0xdb, 0xa3, 0x7e, 0x5d, 0x29, 0x08, 0x71, 0xfc, 0x89, 0x67, 0xa0, 0xcc, 0x1d, 0x53, 0x33, 0x50,
-	0x8a, 0x95, 0xe2, 0xec, 0xe8, 0xf2, 0xf8, 0xee, 0xe3, 0xa9, 0x91, 0x8d, 0x50, 0xe4, 0x57, 0xe3,
-	0x49, 0xf2, 0x03, 0x82, 0x49, 0xc9, 0x55, 0x95, 0xfa, 0xac, 0xe5, 0x99, 0xf4, 0x7a, 0x9b, 0x3a,
-	0x81, 0xbf, 0xd7, 0x71, 0x21, 0x71, 0xbc, 0x08, 0xff, 0xf5, 0x22, 0xd5, 0x75, 0xa3, 0x49, 0x7d,
-	0xd7, 0x30, 0xa9, 0x52, 0xe0, 0x0a, 0xcb, 0xa5, 0x47, 0x8f, 0xa7, 0xfe, 0x55, 0xdd, 0x3f, 0x8d,
-	0x67, 0x61, 0x5c, 0x16, 0x2a, 0x45, 0x49, 0x3d, 0x33, 0x83, 0x67, 0x60, 0x2c, 0xfe, 0xbe, 0xb5,
-	0x7a, 0x4d, 0x29, 0x49, 0x8a, 0xf2, 0x04, 0xd9, 0x00, 0x45, 0xc2, 0x7e, 0xd3, 0x70, 0xec, 0x6d,
-	0xea, 0x07, 0xbd, 0x51, 0x57, 0x60, 0xc4, 0xa3, 0x6d, 0xdb, 0xb7, 0x99, 0x13, 0xc6, 0x2b, 0x32,
-	0x9a, 0x48, 0xc9, 0x51, 0xf8, 0x5f, 0x36, 0x1a, 0x2e, 0x73, 0x7c, 0x4a, 0x1e, 0xa2, 0x8c, 0xa7,
-	0x97, 0x3c, 0x6a, 0x04, 0xb4, 0x4a, 0xdf, 0x69, 0x51, 0x3f, 0xc0, 0x0e, 0xc8, 0xc4, 0x16, 0x0e,
-	0xc7, 0x16, 0x5f, 0xd6, 0x52, 0x1a, 0x68, 0x31, 0x0d, 0xc4, 0xe0, 0x2d, 0xd3, 0xd2, 0xdc, 0x9d,
-	0x9a, 0xc6, 0x19, 0xa5, 0xc9, 0x97, 0x24, 0x66, 0x94, 0x26, 0x79, 0x8a, 0x77, 0x2d, 0xe9, 0xe1,
-	0x63, 0x30, 0xdc, 0x72, 0x7d, 0xea, 0x05, 0x62, 0x0f, 0x23, 0xd5, 0xe8, 0x8b, 0x7c, 0x90, 0x05,
-	0x79, 0xcb, 0xb5, 0x24, 0x90, 0xf5, 0x7f, 0x10, 0x64, 0x06, 0x1e, 0xb9, 0x91, 0x41, 0x71, 0x8d,
-	0x36, 0x68, 0x8a, 0xa2, 0xdb, 0xa1, 0x28, 0x50, 0x36, 0x0d, 0xdf, 0x34, 0x2c, 0x1a, 0xed, 0x27,
-	0xfe, 0x24, 0xbf, 0x17, 0xe0, 0x98, 0x64, 0x6a, 0xb3, 0xe3, 0x98, 0x79, 0x86, 0xfa, 0x9e, 0x2e,
-	0x9e, 0x80, 0x61, 0xcb, 0xeb, 0x54, 0x5b, 0x8e, 0x52, 0xe4, 0x9e, 0xa2, 0xf9, 0x48, 0x86, 0x55,
-	0x18, 0x72, 0xbd, 0x96, 0x43, 0x95, 0x92, 0x34, 0x19, 0x8a, 0xb0, 0x09, 0x23, 0x7e, 0xc0, 0x6f,
-	0x79, 0xad, 0xa3, 0x0c, 0x55, 0xd0, 0xec, 0xd8, 0xe2, 0xca, 0x53, 0xc4, 0x8e, 0xef, 0x64, 0x33,
-	0x32, 0x57, 0x4d, 0x0c, 0xe3, 0x00, 0x46, 0x63, 0x76, 0xfb, 0x4a, 0xb9, 0x52, 0x9c, 0x1d, 0x5b,
-	0xdc, 0x78, 0x4a, 0x2f, 0xaf, 0xba, 0x3c, 0x37, 0x49, 0x17, 0x3b, 0xda, 0x56, 0xea, 0x88, 0x3c,
-	0x40, 0x30, 0xb1, 0x8f, 0x36, 0x9b, 0x2e, 0xcd, 0x8d, 0xb5, 0x05, 0x25, 0xdf, 0xa5, 0xa6, 0xb8,
-	0xf2, 0x63, 0x8b, 0xaf, 0x0c, 0x86, 0x47, 0xdc, 0x69, 0x84, 0x4f, 0x58, 0x27, 0xab, 0x70, 0x5c,
-	0x9a, 0xde, 0x30, 0x02, 0xb3, 0x9e, 0x07, 0x8a, 0x1f, 0x20, 0xd7, 0xc9, 0x24, 0xa2, 0x50, 0x44,
-	0x3e, 0x44, 0xa0, 0xca, 0x94, 0x65, 0x8d, 0xc6, 0x6d, 0xc3, 0xdc, 0xc9, 0x37, 0x57, 0xb0, 0x2d,
-	0x61, 0xab, 0xb8, 0x0c, 0xdc, 0xd6, 0xee, 0xe3, 0xa9, 0xc2, 0xea, 0xb5, 0x6a, 0xc1, 0xb6, 0xfe,
-	0x3e, 0x93, 0xc8, 0xaf, 0x7b, 0x80, 0x44, 0xe7, 0x90, 0x07, 0x84, 0xc0, 0xa8, 0xd3, 0x35, 0xc9,
-	0xa6, 0xe2, 0x27, 0x48, 0xae, 0x93, 0x50, 0x6e, 0x53, 0x4f, 0xdc, 0x12, 0x39, 0xb1, 0xc6, 0x42,
-	0x0e, 0xbe, 0xe6, 0xb1, 0x96, 0xab, 0x0c, 0xc9, 0x51, 0x14, 0x22, 0xac, 0x40, 0x69, 0xc7, 0x76,
-	0x2c, 0x65, 0x58, 0x9a, 0x12, 0x12, 0xf2, 0x79, 0x01, 0xa6, 0xba, 0x6c, 0xab, 0xef, 0x99, 0x3d,
-	0x03, 0x7b, 0x4b, 0x79, 0x55, 0xde, 0xc7, 0x2b, 0x8e, 0x5f, 0x0c, 0xb6, 0x3a, 0x2e, 0x55, 0x46,
-	0x64, 0xfc, 0x89, 0x98, 0xfc, 0x89, 0xa0, 0xd2, 0x25, 0x36, 0xfd, 0x53, 0xe3, 0x33, 0x12, 0x9c,
-	0x6d, 0xe6, 0x99, 0x54, 0x29, 0x27, 0x5c, 0x47, 0xd5, 0x50, 0x44, 0xae, 0xc0, 0x89, 0xae, 0x54,
-	0x0f, 0x5f, 0x55, 0x9e, 0xb0, 0x9b, 0xd1, 0x9b, 0x1d, 0x6e, 0x3b, 0x4e, 0xd8, 0xb1, 0x94, 0xfc,
-	0x54, 0xc8, 0x66, 0x00, 0x66, 0xad, 0xb1, 0x5a, 0x4e, 0x59, 0x72, 0x90, 0x80, 0x29, 0x50, 0x76,
-	0x99, 0x95, 0xc6, 0xaa, 0x1a, 0x7f, 0xf2, 0xd5, 0x26, 0x73, 0x02, 0x83, 0xd7, 0x8c, 0x99, 0x10,
-	0xa5, 0x62, 0x1e, 0x6e, 0xdf, 0x76, 0x4c, 0xba, 0x49, 0x4d, 0xe6, 0x58, 0xbe, 0x88, 0x55, 0x31,
-	0x0e, 0xb7, 0x3c, 0x83, 0x6f, 0xc0, 0xa8, 0xf8, 0xde, 0xb2, 0x9b, 0x54, 0x19, 0x16, 0x6f, 0xc6,
-	0x9c, 0x16, 0x16, 0xa7, 0x9a, 0x5c, 0x9c, 0xa6, 0xf9, 0x91, 0x17, 0xa7, 0x5a, 0x7b, 0x41, 0xe3,
-	0x2b, 0xaa, 0xe9, 0x62, 0x8e, 0x2b, 0x30, 0xec, 0xc6, 0x9a, 0xed, 0x88, 0x77, 0x21, 0x75, 0x98,
-	0x8a, 0x79, 0x42, 0xda, 0x66, 0x8d, 0x06, 0x7b, 0x57, 0x90, 0x30, 0x49, 0x48, 0xa1, 0x8c, 0xbc,
-	0x07, 0x23, 0x6b, 0xac, 0x76, 0xdd, 0x09, 0xbc, 0x0e, 0xa7, 0x01, 0xdf, 0x0e, 0x75, 0xb2, 0x41,
-	0x8f, 0x85, 0x78, 0x1d, 0x46, 0x03, 0xbb, 0x49, 0x37, 0x03, 0xa3, 0xe9, 0x46, 0xf9, 0xfd, 0x09,
-	0x70, 0x27, 0xc8, 0x62, 0x13, 0x44, 0x87, 0xff, 0x27, 0xaf, 0xd0, 0x16, 0xf5, 0x9a, 0xb6, 0x63,
-	0xe4, 0xb2, 0x9e, 0x4c, 0x80, 0xda, 0x6d, 0x41, 0x54, 0x8a, 0x5d, 0x85, 0x43, 0x31, 0x91, 0x22,
-	0x22, 0x68, 0xf0, 0x1f, 0xe9, 0x65, 0x59, 0x4f, 0xcc, 0x45, 0x5c, 0xdc, 0x3b, 0x49, 0x3a, 0xa0,
-	0xdc, 0x34, 0x1c, 0xa3, 0x46, 0xad, 0xc4, 0x50, 0x42, 0xc9, 0x37, 0x61, 0xc8, 0x0e, 0x68, 0xd3,
-	0x57, 0x90, 0x78, 0x7e, 0x9f, 0xe6, 0x91, 0x4f, 0xae, 0xb9, 0xbd, 0xbd, 0x5d, 0x0d, 0xad, 0x2e,
-	0x7e, 0x73, 0x1c, 0xb0, 0xfc, 0xe0, 0x51, 0xaf, 0x6d, 0x9b, 0x14, 0xdf, 0x47, 0x50, 0x5a, 0xb3,
-	0xfd, 0x00, 0x9f, 0xcc, 0x98, 0xda, 0xdb, 0x02, 0xa8, 0x03, 0x7a, 0x67, 0xb9, 0x2b, 0x32, 0x71,
-	0xef, 0x97, 0x3f, 0x3e, 0x2d, 0x1c, 0xc3, 0x47, 0x44, 0x37, 0xd5, 0x5e, 0x90, 0x9b, 0x1b, 0x1f,
-	0x7f, 0x84, 0x00, 0x73, 0xb5, 0x6c, 0x3f, 0x80, 0xcf, 0xf5, 0xc2, 0xd7, 0xa5, 0x6f, 0x50, 0x4f,
-	0x4a, 0xac, 0xd1, 0x78, 0xbb, 0xc6, 0x39, 0x22, 0x14, 0x04, 0x80, 0x39, 0x01, 0x60, 0x1a, 0x93,
-	0x6e, 0x00, 0xf4, 0x3b, 0x9c, 0x0a, 0x77, 0x75, 0x1a, 0xfa, 0xfd, 0x0a, 0xc1, 0xd0, 0xeb, 0x22,
-	0xdf, 0xf6, 0x89, 0xd0, 0xc6, 0x60, 0x22, 0x24, 0x7c, 0x09, 0xa8, 0xe4, 0x94, 0x80, 0x79, 0x12,
-	0x9f, 0x88, 0x61, 0xfa, 0x81, 0x47, 0x8d, 0x66, 0x06, 0xed, 0x05, 0x84, 0x1f, 0x22, 0x18, 0x0e,
-	0xdb, 0x02, 0x7c, 0xba, 0x17, 0xc4, 0x4c, 0xdb, 0xa0, 0x0e, 0xa8, 0xf8, 0x26, 0x67, 0x05, 0xc0,
-	0x53, 0xa4, 0xeb, 0x41, 0x2e, 0x65, 0x3a, 0x87, 0x4f, 0x10, 0x14, 0x57, 0x68, 0x5f, 0x9a, 0x0d,
-	0x0a, 0xd9, 0xbe, 0xd0, 0x75, 0x39, 0x61, 0x7c, 0x0f, 0xc1, 0xf8, 0x0a, 0x0d, 0xe2, 0xe6, 0xcd,
-	0xef, 0x1d, 0xbe, 0x4c, 0x7f, 0xa7, 0x4e, 0x68, 0x52, 0xd7, 0x1c, 0x4f, 0x25, 0x59, 0x62, 0x5e,
-	0xb8, 0x3e, 0x83, 0x4f, 0xe7, 0x91, 0xab, 0x99, 0xf8, 0xfc, 0x11, 0xc1, 0x70, 0x58, 0xf8, 0xf6,
-	0x76, 0x9f, 0xe9, 0xa7, 0x06, 0x16, 0xa3, 0xeb, 0x02, 0xe8, 0x15, 0xf5, 0x42, 0x77, 0xa0, 0xf2,
-	0x7a, 0x9e, 0x66, 0x2d, 0x23, 0x30, 0x34, 0x81, 0x3e, 0x7b, 0xb2, 0xdf, 0x21, 0x80, 0xb4, 0x72,
-	0xc7, 0x67, 0xf3, 0x37, 0x21, 0x55, 0xf7, 0xea, 0x00, 0x6b, 0x77, 0xa2, 0x89, 0xcd, 0xcc, 0xaa,
-	0x95, 0xbc, 0xa8, 0xf3, 0xca, 0x7e, 0x49, 0xd4, 0xf7, 0xf8, 0x4b, 0x04, 0x43, 0xa2, 0x42, 0xc4,
-	0xd3, 0xbd, 0x00, 0xcb, 0x05, 0xe4, 0xc0, 0x82, 0x3e, 0x23, 0x70, 0x56, 0x16, 0xf3, 0x88, 0xb9,
-	0x84, 0xe6, 0x70, 0x1b, 0x86, 0xc3, 0x22, 0xad, 0x37, 0x2b, 0x32, 0x45, 0x9c, 0x5a, 0xc9, 0xc9,
-	0x8f, 0x21, 0x31, 0xa3, 0x3b, 0x31, 0x97, 0x7b, 0x27, 0xbe, 0x46, 0x50, 0xe2, 0xdd, 0x1b, 0x3e,
-	0xd5, 0xcb, 0x9e, 0xd4, 0x0b, 0x0f, 0x2c, 0x2a, 0xe7, 0x04, 0xb4, 0xd3, 0x24, 0xff, 0xf4, 0x3a,
-	0x8e, 0xc9, 0x43, 0xf3, 0x00, 0xc1, 0xe1, 0xbd, 0xaf, 0x28, 0x3e, 0x91, 0x71, 0x92, 0x7d, 0xa6,
-	0xd5, 0x6c, 0x08, 0x7b, 0xbd, 0xc0, 0xe4, 0xaa, 0x40, 0xb1, 0x84, 0x2f, 0xf5, 0xbd, 0x10, 0xeb,
-	0xf1, 0x25, 0xe6, 0x86, 0xe6, 0x93, 0x86, 0x16, 0x7f, 0x8f, 0x60, 0x3c, 0xb6, 0xbb, 0xe5, 0x51,
-	0x9a, 0x0f, 0x6b, 0x40, 0xfc, 0xe7, 0x8e, 0xc8, 0x65, 0x81, 0xfd, 0x79, 0x7c, 0xf1, 0x80, 0xd8,
-	0x63, 0xcc, 0xf3, 0x01, 0x87, 0xf9, 0x2d, 0x82, 0x91, 0xb8, 0x2f, 0xc5, 0x67, 0x7a, 0x32, 0x29,
-	0xdb, 0xb9, 0x0e, 0xec, 0xf4, 0x75, 0x81, 0xfd, 0x2c, 0x99, 0xce, 0x3b, 0x7d, 0x2f, 0x72, 0xce,
-	0x19, 0xf0, 0x19, 0x02, 0x9c, 0x94, 0x67, 0x49, 0xc1, 0x86, 0x67, 0x32, 0xae, 0x7a, 0x56, 0x7e,
-	0xea, 0x99, 0xbe, 0x7a, 0xd9, 0x54, 0x3e, 0x97, 0x9b, 0xca, 0x59, 0xe2, 0xff, 0x63, 0x04, 0x63,
-	0x2b, 0x34, 0x29, 0x5c, 0x72, 0x02, 0x99, 0xed, 0xbc, 0xd5, 0xd9, 0xfe, 0x8a, 0x11, 0xa2, 0xf3,
-	0x02, 0xd1, 0x0c, 0xce, 0x0f, 0x55, 0x0c, 0xe0, 0x0b, 0x04, 0xff, 0x8e, 0xb2, 0x58, 0x24, 0x39,
-	0xdf, 0xcf, 0x53, 0x26, 0xe9, 0x1d, 0x1c, 0xd7, 0x73, 0x02, 0xd7, 0x3c, 0x39, 0x10, 0xae, 0xa5,
-	0xa8, 0x81, 0xbd, 0x8f, 0xe0, 0x50, 0x9c, 0xc4, 0x22, 0x7c, 0xf3, 0xfd, 0x3c, 0x3e, 0x69, 0xd2,
-	0x8b, 0x02, 0x36, 0x77, 0xb0, 0x80, 0xbd, 0x8f, 0xa0, 0x1c, 0x75, 0x7a, 0x39, 0xef, 0x82, 0xd4,
-	0x0a, 0xaa, 0x47, 0x33, 0x5a, 0x71, 0xa7, 0x43, 0x5e, 0x10, 0x6e, 0x17, 0xb0, 0x9e, 0xe7, 0xd6,
-	0x65, 0x96, 0xaf, 0xdf, 0x89, 0x5a, 0xc0, 0xbb, 0x7a, 0x83, 0xd5, 0xfc, 0x0b, 0x68, 0xf9, 0xf2,
-	0xa3, 0xdd, 0x49, 0xf4, 0xf3, 0xee, 0x24, 0xfa, 0x6d, 0x77, 0x12, 0xbd, 0xa1, 0xe5, 0xfd, 0xa4,
-	0xbf, 0xff, 0xaf, 0x8f, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xed, 0xa9, 0x4e, 0x16, 0x0f, 0x19,
-	0x00, 0x00,
+	// 1889 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x59, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xd9, 0x4d, 0xb2, 0x99, 0x84, 0xd2, 0x0e, 0x6d, 0x58, 0xdc, 0xa4, 0x8d, 0xa6, 0x69,
+	0x9a, 0x6e, 0x1b, 0x3b, 0x0d, 0x15, 0x54, 0x51, 0xa5, 0x96, 0xf4, 0x2b, 0x41, 0x25, 0x0d, 0x4e,
+	0x2a, 0x24, 0x24, 0x84, 0x5c, 0x7b, 0xb2, 0x6b, 0xb2, 0x6b, 0x1b, 0xdb, 0x1b, 0xb4, 0x54, 0x3d,
+	0x50, 0xf5, 0x88, 0xf8, 0x10, 0x1c, 0x40, 0x02, 0x81, 0x38, 0x71, 0xe0, 0x86, 0xb8, 0x70, 0xe0,
+	0x86, 0xd4, 0x23, 0x12, 0x9c, 0x2b, 0x54, 0xf1, 0x37, 0x70, 0x43, 0xe2, 0xcd, 0xf8, 0x6b, 0xbc,
+	0xd9, 0xf5, 0x6e, 0x95, 0xe5, 0xd0, 0xc3, 0x4a, 0xf6, 0x9b, 0xe7, 0xf7, 0x7e, 0xf3, 0xe6, 0x37,
+	0xf3, 0xde, 0x9b, 0x45, 0xb3, 0x3e, 0xf5, 0x76, 0xa9, 0xa7, 0xea, 0xae, 0x5b, 0xb7, 0x0c, 0x3d,
+	0xb0, 0x1c, 0x5b, 0x7c, 0x56, 0x5c, 0xcf, 0x09, 0x1c, 0x3c, 0x2e, 0x88, 0xe4, 0xc3, 0x55, 0xa7,
+	0xea, 0x70, 0xb9, 0xca, 0x9e, 0x42, 0x15, 0x79, 0xaa, 0xea, 0x38, 0xd5, 0x3a, 0x85, 0x8f, 0x2d,
+	0x55, 0xb7, 0x6d, 0x27, 0xe0, 0xca, 0x7e, 0x34, 0x4a, 0x76, 0x2e, 0xf8, 0x8a, 0xe5, 0xf0, 0x51,
+	0xc3, 0xf1, 0xa8, 0xba, 0x7b, 0x4e, 0xad, 0x52, 0x9b, 0x7a, 0x7a, 0x40, 0xcd, 0x48, 0xe7, 0x7c,
+	0xaa, 0xd3, 0xd0, 0x8d, 0x9a, 0x05, 0xa3, 0x2d, 0xd5, 0xdd, 0xa9, 0x32, 0x81, 0xaf, 0x36, 0x68,
+	0xa0, 0x77, 0xfa, 0x6a, 0xad, 0x6a, 0x05, 0xb5, 0xe6, 0x1d, 0xc5, 0x70, 0x1a, 0xaa, 0xee, 0x71,
+	0x60, 0xef, 0xf2, 0x87, 0x05, 0xc3, 0x4c, 0xbf, 0x16, 0xa7, 0xb7, 0x7b, 0x4e, 0xaf, 0xbb, 0x35,
+	0x7d, 0xaf, 0xa9, 0x95, 0x3c, 0x53, 0x1e, 0x75, 0x9d, 0x28, 0x56, 0xfc, 0xd1, 0x0a, 0x1c, 0x80,
+	0x97, 0x3e, 0x86, 0x36, 0x48, 0x0d, 0x1d, 0x7c, 0x35, 0xf5, 0xf5, 0x46, 0x13, 0xe6, 0x80, 0x31,
+	0x2a, 0xda, 0x7a, 0x83, 0x96, 0xa5, 0x19, 0x69, 0x7e, 0x4c, 0xe3, 0xcf, 0xb8, 0x8c, 0x46, 0x3d,
+	0xba, 0xed, 0x51, 0xbf, 0x56, 0x1e, 0xe2, 0xe2, 0xf8, 0x15, 0xcf, 0xa1, 0x51, 0xe6, 0x98, 0x1a,
+	0x41, 0xb9, 0x30, 0x53, 0x98, 0x1f, 0x5b, 0x99, 0x78, 0xfc, 0xe8, 0x78, 0x69, 0x23, 0x14, 0xf9,
+	0x5a, 0x3c, 0x48, 0x7e, 0x91, 0xd0, 0x31, 0xc1, 0x95, 0x46, 0x7d, 0xa7, 0xe9, 0x19, 0xf4, 0xda,
+	0x2e, 0xb5, 0x03, 0xbf, 0xdd, 0xf1, 0x50, 0xe2, 0x78, 0x09, 0x1d, 0xf2, 0x22, 0xd5, 0x75, 0x78,
+	0xf7, 0x5d, 0xdd, 0xa0, 0x00, 0x01, 0x14, 0x56, 0x8a, 0x0f, 0x1f, 0x1d, 0x7f, 0x46, 0xdb, 0x3b,
+	0x8c, 0xe7, 0xd1, 0x84, 0x28, 0x04, 0x5c, 0xa9, 0x7a, 0x66, 0x04, 0xc0, 0x8f, 0xc7, 0xef, 0xb7,
+	0xd7, 0xae, 0x96, 0x8b, 0x82, 0xa2, 0x38, 0x40, 0x36, 0x50, 0x59, 0xc0, 0xfe, 0xba, 0x6e, 0x5b,
+	0xdb, 0xd4, 0x0f, 0xba, 0xa3, 0x9e, 0x41, 0x25, 0x8f, 0xee, 0x5a, 0x3e, 0x28, 0x87, 0xf1, 0x8a,
+	0x8c, 0x26, 0x52, 0x72, 0x04, 0x3d, 0x9f, 0x8d, 0x86, 0x0b, 0xec, 0xa3, 0xe4, 0x7b, 0x29, 0xe3,
+	0xe9, 0x8a, 0x47, 0x61, 0xc1, 0x35, 0xfa, 0x5e, 0x13, 0xdc, 0x61, 0x1b, 0x89, 0xc4, 0xe6, 0x0e,
+	0xc7, 0x97, 0xae, 0x2b, 0x29, 0x0d, 0x94, 0x98, 0x06, 0xfc, 0xe1, 0x1d, 0x03, 0x98, 0xb2, 0x53,
+	0x55, 0x18, 0xa3, 0x14, 0x71, 0x93, 0xc4, 0x8c, 0x52, 0x04, 0x4f, 0xf1, 0xac, 0x05, 0x3d, 0x3c,
+	0x89, 0x46, 0x9a, 0x2e, 0x90, 0x28, 0xe0, 0x73, 0x28, 0x69, 0xd1, 0x1b, 0x79, 0x90, 0x05, 0x79,
+	0xdb, 0x35, 0x05, 0x90, 0xb5, 0xff, 0x11, 0x64, 0x06, 0x1e, 0xd9, 0xcd, 0xa0, 0xb8, 0x4a, 0xeb,
+	0x34, 0x45, 0xd1, 0x69, 0x51, 0x80, 0xc3, 0x86, 0xee, 0x1b, 0xba, 0x49, 0xa3, 0xf9, 0xc4, 0xaf,
+	0xf8, 0x2c, 0x3a, 0x04, 0x80, 0x5c, 0xbd, 0xca, 0x2d, 0x6d, 0x38, 0x60, 0xb3, 0x05, 0xac, 0x61,
+	0x3c, 0xdf, 0x3b, 0x40, 0xfe, 0x2d, 0xa2, 0x49, 0xc1, 0xf1, 0x66, 0xcb, 0x36, 0xf2, 0xdc, 0xf6,
+	0xe4, 0x02, 0x9e, 0x42, 0x23, 0xa6, 0xd7, 0xd2, 0x9a, 0x36, 0xf7, 0x59, 0x8a, 0xc6, 0x23, 0x19,
+	0x96, 0xd1, 0xb0, 0xeb, 0x35, 0x6d, 0x0a, 0xec, 0x4c, 0x07, 0x43, 0x11, 0x36, 0x50, 0xc9, 0x0f,
+	0xd8, 0x99, 0x50, 0x6d, 0x95, 0x87, 0x61, 0x78, 0x7c, 0xe9, 0xc6, 0x3e, 0x22, 0xcd, 0x66, 0xb2,
+	0x19, 0x99, 0xd3, 0x12, 0xc3, 0x38, 0x40, 0x63, 0xf1, 0x5e, 0xf0, 0xcb, 0xa3, 0xb0, 0xc7, 0xc7,
+	0x97, 0x36, 0xf6, 0xe9, 0xe5, 0x96, 0xcb, 0x4e, 0x32, 0xe1, 0x18, 0x88, 0xa6, 0x95, 0x3a, 0x02,
+	0xb2, 0x3f, 0xeb, 0xd1, 0xc0, 0x6b, 0xc5, 0x80, 0xca, 0x25, 0x3e, 0xbf, 0xd5, 0x7d, 0x78, 0xd6,
+	0x44, 0x7b, 0x5a, 0xd6, 0x3c, 0xae, 0xa3, 0xf1, 0x1a, 0xd5, 0xeb, 0x41, 0xed, 0x4a, 0x8d, 0x1a,
+	0x3b, 0xe5, 0x31, 0xee, 0xed, 0xb5, 0x7d, 0xce, 0x73, 0x35, 0xb5, 0xa8, 0x89, 0xe6, 0xf1, 0x31,
+	0x34, 0x1a, 0x58, 0x0d, 0xea, 0x34, 0x83, 0x32, 0x12, 0x38, 0x11, 0x0b, 0xd9, 0xa2, 0x03, 0xa3,
+	0x9a, 0xb4, 0x3c, 0x2e, 0x2e, 0x3a, 0x17, 0x91, 0x2f, 0x25, 0x34, 0xb5, 0x67, 0xfb, 0x6d, 0xba,
+	0x34, 0x97, 0x85, 0x26, 0x2a, 0xfa, 0xa0, 0xc2, 0x8f, 0xce, 0xfd, 0xcd, 0x4b, 0xa4, 0x3e, 0x58,
+	0x8c, 0xb0, 0x71, 0xeb, 0x64, 0x0d, 0xbd, 0x20, 0x0c, 0x6f, 0xe8, 0x81, 0x51, 0xcb, 0x03, 0xc5,
+	0xa8, 0xcd, 0x74, 0x32, 0x07, 0x7a, 0x28, 0x22, 0x3f, 0x48, 0x48, 0x16, 0xb7, 0xbe, 0x53, 0xaf,
+	0xdf, 0xd1, 0x21, 0x8c, 0xb9, 0xe6, 0x86, 0x2c, 0x93, 0xdb, 0x2a, 0xac, 0x20, 0x66, 0x0b, 0x32,
+	0xd1, 0xd0, 0xda, 0x55, 0x0d, 0xa4, 0xfb, 0xd8, 0x63, 0xe2, 0xfe, 0x1d, 0xee, 0x78, 0x96, 0xff,
+	0xd9, 0x06, 0x35, 0xe2, 0x70, 0x1e, 0x54, 0x82, 0xc6, 0xec, 0x8e, 0xe9, 0x2c, 0x15, 0x3f, 0x41,
+	0x1a, 0x03, 0x36, 0x41, 0xa2, 0xe7, 0x08, 0xc5, 0x14, 0x16, 0x0b, 0xd9, 0xf4, 0xaa, 0x9e, 0xd3,
+	0x74, 0x01, 0xbf, 0x10, 0x67, 0x2e, 0x82, 0x53, 0xb1, 0xb8, 0x63, 0xd9, 0x66, 0x79, 0x44, 0x18,
+	0xe2, 0x12, 0xf2, 0xd5, 0x10, 0x3a, 0xde, 0x61, 0x5a, 0x3d, 0x57, 0xf5, 0x29, 0x98, 0x5b, 0xca,
+	0xbc, 0xd1, 0x3d, 0xcc, 0x63, 0xf8, 0xf9, 0xc3, 0x56, 0xcb, 0xa5, 0x70, 0xea, 0x08, 0xf8, 0x13,
+	0x31, 0xf9, 0x47, 0x42, 0x33, 0x1d, 0x62, 0xd3, 0x3b, 0x09, 0x3d, 0x25, 0xc1, 0xd9, 0x76, 0xc0,
+	0x05, 0x04, 0x27, 0xde, 0x0d, 0x92, 0x16, 0x8a, 0xc8, 0x25, 0x74, 0xb4, 0x23, 0xd5, 0xc3, 0xfa,
+	0x85, 0x6d, 0x96, 0x46, 0x54, 0x1d, 0x85, 0xd3, 0x8e, 0x37, 0x4b, 0x2c, 0x25, 0xbf, 0x0d, 0x65,
+	0xcf, 0x08, 0xc7, 0xbc, 0xe9, 0x54, 0x73, 0x0a, 0xc0, 0x7e, 0x02, 0x06, 0x99, 0xdd, 0x75, 0xcc,
+	0x34, 0x56, 0x5a, 0xfc, 0xca, 0xbe, 0x36, 0x1c, 0x3b, 0xd0, 0x59, 0x75, 0x9e, 0x09, 0x51, 0x2a,
+	0x66, 0xe1, 0xf6, 0x2d, 0xdb, 0xa0, 0x9b, 0x14, 0x64, 0xa6, 0xcf, 0x63, 0x55, 0x88, 0xc3, 0x2d,
+	0x8e, 0xe0, 0x55, 0x34, 0xc6, 0xdf, 0xb7, 0xe0, 0x94, 0x86, 0xb8, 0xb1, 0x0c, 0x51, 0x51, 0xc2,
+	0x36, 0x40, 0x11, 0xdb, 0x80, 0xf4, 0x04, 0x65, 0x6d, 0x00, 0x1c, 0x9d, 0x0a, 0xfb, 0x42, 0x4b,
+	0x3f, 0x66, 0xb8, 0xc0, 0x7b, 0xfd, 0x26, 0xa8, 0xfb, 0x9c, 0x83, 0xb1, 0xc3, 0x54, 0xcc, 0x8e,
+	0xac, 0x6d, 0x38, 0xf5, 0x9c, 0xf7, 0x39, 0x09, 0x93, 0x23, 0x2b, 0x94, 0x91, 0x0f, 0x50, 0x09,
+	0x02, 0x77, 0xcd, 0x86, 0x1c, 0xc6, 0x68, 0xc0, 0xa6, 0x03, 0x95, 0x74, 0x26, 0xe8, 0xb1, 0x10,
+	0xaf, 0x83, 0x37, 0xf0, 0xba, 0x19, 0xe8, 0x0d, 0x37, 0xca, 0x00, 0x4f, 0x80, 0x3b, 0x41, 0x16,
+	0x9b, 0x20, 0x2a, 0x7a, 0x31, 0xc9, 0xe0, 0x5b, 0xd4, 0x6b, 0x58, 0xb6, 0x9e, 0xcb, 0x7a, 0x32,
+	0x85, 0xe4, 0x4e, 0x1f, 0x44, 0x45, 0xef, 0x65, 0x74, 0x20, 0x26, 0x52, 0x44, 0x04, 0x05, 0x3d,
+	0x27, 0xe4, 0x9e, 0xf5, 0xc4, 0x5c, 0xc4, 0xc5, 0xf6, 0x41, 0xd2, 0x42, 0x65, 0x28, 0xca, 0xf5,
+	0x2a, 0x35, 0x13, 0x43, 0x09, 0x25, 0xdf, 0x46, 0xc3, 0x56, 0x40, 0x1b, 0x3e, 0x58, 0x28, 0xec,
+	0xb3, 0x40, 0x4a, 0xb6, 0xb9, 0xb5, 0xbd, 0xad, 0x85, 0x56, 0xc9, 0xdd, 0xcc, 0x86, 0x58, 0xb5,
+	0x7c, 0xd6, 0x5d, 0x31, 0x85, 0xee, 0x94, 0x9e, 0x83, 0x85, 0xf5, 0x9c, 0x06, 0x34, 0x1c, 0xac,
+	0x1e, 0x2c, 0xac, 0x1c, 0x88, 0x72, 0xd5, 0xc8, 0x75, 0x2e, 0xd5, 0xa2, 0x51, 0xd8, 0x4c, 0xc5,
+	0xc0, 0x01, 0xad, 0x02, 0xd7, 0x9a, 0x88, 0xb4, 0x8a, 0x5b, 0x20, 0xd3, 0xf8, 0x08, 0xb9, 0x85,
+	0xa6, 0x37, 0xa8, 0x6d, 0x5a, 0x76, 0x35, 0x09, 0xef, 0x15, 0x1d, 0x38, 0x56, 0xef, 0x27, 0x4d,
+	0xb2, 0x54, 0xd6, 0x96, 0x26, 0x97, 0x1e, 0xc8, 0x08, 0x8b, 0x09, 0x1e, 0x3a, 0x48, 0x0b, 0x36,
+	0xd8, 0x27, 0x12, 0x2a, 0xde, 0x84, 0xa9, 0xe1, 0xe9, 0x4c, 0x60, 0xda, 0x5b, 0x47, 0x79, 0x40,
+	0x75, 0x05, 0x73, 0x45, 0xa6, 0xee, 0xff, 0xf1, 0xf7, 0xe7, 0x43, 0x93, 0xf8, 0x30, 0xef, 0xc2,
+	0xa1, 0x95, 0x16, 0xbe, 0xf2, 0xf1, 0x47, 0x12, 0xc2, 0x4c, 0x2d, 0xdb, 0x47, 0xe2, 0x33, 0xdd,
+	0xf0, 0x75, 0xe8, 0x37, 0xe5, 0x69, 0x61, 0x0f, 0x28, 0xac, 0xcd, 0x67, 0x8c, 0xe7, 0x0a, 0x1c,
+	0x40, 0x85, 0x03, 0x98, 0xc5, 0xa4, 0x13, 0x00, 0xf5, 0x2e, 0x8b, 0xe5, 0x3d, 0x95, 0x86, 0x7e,
+	0xbf, 0x95, 0xd0, 0xf0, 0x9b, 0x3c, 0x7b, 0xf4, 0x88, 0xd0, 0xc6, 0x60, 0x22, 0xc4, 0x7d, 0x71,
+	0xa8, 0xe4, 0x04, 0x87, 0x39, 0x8d, 0x8f, 0xc6, 0x30, 0xa1, 0x70, 0xa7, 0x7a, 0x23, 0x83, 0x76,
+	0x51, 0xc2, 0xd0, 0x59, 0x8e, 0x84, 0xed, 0x24, 0x3e, 0xd9, 0x0d, 0x62, 0xa6, 0xdd, 0x94, 0x07,
+	0xd4, 0xb4, 0x91, 0xd3, 0x1c, 0xe0, 0x09, 0xd2, 0x71, 0x21, 0x97, 0x33, 0x1d, 0xe7, 0x67, 0x12,
+	0x2a, 0xdc, 0xa0, 0x3d, 0x69, 0x36, 0x28, 0x64, 0x7b, 0x42, 0xd7, 0x61, 0x85, 0xf1, 0x7d, 0x09,
+	0x4d, 0x00, 0xa6, 0xb8, 0xe9, 0xf7, 0xbb, 0x87, 0x2f, 0x73, 0x2f, 0x20, 0x4f, 0x29, 0xc2, 0x6d,
+	0x4b, 0x3c, 0x94, 0x9c, 0x79, 0x0b, 0xdc, 0xf5, 0x29, 0x7c, 0x32, 0x8f, 0x5c, 0x8d, 0xc4, 0xe7,
+	0xaf, 0xb0, 0x7a, 0x61, 0xa1, 0xdf, 0xdd, 0x7d, 0xa6, 0x0f, 0x1f, 0x58, 0x8c, 0xae, 0x71, 0xa0,
+	0x97, 0xe4, 0xc5, 0xce, 0x40, 0xc5, 0xef, 0x59, 0xd2, 0x00, 0x08, 0xba, 0xc2, 0xd1, 0x67, 0x57,
+	0xf6, 0x27, 0x09, 0xa1, 0xb4, 0x53, 0xc1, 0xa7, 0xf3, 0x27, 0x21, 0x74, 0x33, 0xf2, 0x00, 0x7b,
+	0x15, 0xa2, 0xf0, 0xc9, 0xcc, 0xcb, 0x33, 0x79, 0x51, 0x67, 0x9d, 0xcc, 0x32, 0xef, 0x67, 0xf0,
+	0x37, 0xb0, 0xad, 0x79, 0xbd, 0x8b, 0x67, 0xbb, 0x01, 0x16, 0xcb, 0xe1, 0x81, 0x05, 0x7d, 0x8e,
+	0xe3, 0x9c, 0x59, 0xca, 0x23, 0xe6, 0xb2, 0x54, 0xc1, 0xbb, 0x68, 0x24, 0x2c, 0x39, 0xbb, 0xb3,
+	0x22, 0x53, 0x92, 0xca, 0x33, 0x39, 0xe7, 0x63, 0x48, 0xcc, 0x68, 0x4f, 0x54, 0x72, 0xf7, 0xc4,
+	0x77, 0x90, 0x0f, 0x58, 0x7f, 0x8b, 0x4f, 0x74, 0xb3, 0x27, 0xdc, 0x8a, 0x0c, 0x2c, 0x2a, 0x67,
+	0x38, 0xb4, 0x93, 0x24, 0x7f, 0xf5, 0xc0, 0x31, 0x0b, 0x0d, 0x74, 0xc9, 0x07, 0xdb, 0x6b, 0x02,
+	0x7c, 0x34, 0xe3, 0x24, 0x5b, 0x74, 0xc8, 0xd9, 0x10, 0x76, 0xab, 0x27, 0xc8, 0x65, 0x8e, 0x62,
+	0x19, 0x5f, 0xe8, 0xb9, 0x21, 0xd6, 0xe3, 0x4d, 0xcc, 0x0c, 0x2d, 0xa4, 0x57, 0x1b, 0x3f, 0xc3,
+	0x89, 0x12, 0xdb, 0xdd, 0xf2, 0x28, 0xcd, 0x87, 0x35, 0x20, 0xfe, 0x33, 0x47, 0xe4, 0x22, 0xc7,
+	0xfe, 0x32, 0x3e, 0xdf, 0x27, 0xf6, 0x18, 0xf3, 0x42, 0xc0, 0x60, 0xfe, 0x28, 0xa1, 0x52, 0xdc,
+	0x87, 0xe3, 0x53, 0x5d, 0x99, 0x94, 0xed, 0xd4, 0x07, 0xb6, 0xfa, 0x2a, 0xc7, 0x7e, 0x9a, 0xcc,
+	0xe6, 0xad, 0xbe, 0x17, 0x39, 0x67, 0x0c, 0xf8, 0x54, 0x42, 0xe3, 0x42, 0x3d, 0x86, 0xe7, 0xbb,
+	0x21, 0x6e, 0x2f, 0xda, 0xfa, 0x65, 0xc2, 0x22, 0x47, 0x54, 0xc1, 0xf3, 0x79, 0x88, 0x6a, 0xa1,
+	0x71, 0xd5, 0x64, 0x10, 0xbe, 0x80, 0xaa, 0x25, 0xa9, 0x7f, 0x93, 0x92, 0x0d, 0xcf, 0x65, 0xfc,
+	0x75, 0x2d, 0xad, 0xe5, 0x53, 0x3d, 0xf5, 0xb2, 0xd9, 0xa5, 0x92, 0x9b, 0x5d, 0x9c, 0xc4, 0xff,
+	0x43, 0x09, 0x4d, 0x86, 0x75, 0x63, 0x7b, 0x35, 0x89, 0x2b, 0x19, 0x97, 0xb9, 0xc5, 0xe6, 0xc0,
+	0x56, 0x7a, 0x99, 0xa3, 0x3f, 0x5f, 0x59, 0xea, 0x0b, 0xbd, 0xea, 0x86, 0xa0, 0xd4, 0xbb, 0x96,
+	0x79, 0x0f, 0x7f, 0x0c, 0x8b, 0x0e, 0xd9, 0x3a, 0x5e, 0xac, 0x1c, 0x9a, 0x66, 0x6f, 0x69, 0xe4,
+	0xf9, 0xde, 0x8a, 0x51, 0x70, 0xcf, 0x72, 0x78, 0x73, 0x38, 0x9f, 0x88, 0x31, 0x80, 0xaf, 0x25,
+	0xf4, 0x6c, 0x94, 0x23, 0x22, 0xc9, 0xd9, 0x5e, 0x9e, 0x32, 0x29, 0xa5, 0x7f, 0x5c, 0x2f, 0x71,
+	0x5c, 0x0b, 0xa4, 0x2f, 0x5c, 0xcb, 0xd1, 0x65, 0x07, 0x54, 0xf6, 0x07, 0xe2, 0x14, 0x11, 0xe1,
+	0x5b, 0xe8, 0xe5, 0xf1, 0x49, 0x53, 0x4a, 0x14, 0xb0, 0x4a, 0x7f, 0x01, 0xfb, 0x50, 0x42, 0xa3,
+	0xd1, 0xad, 0x40, 0x4e, 0xd6, 0x15, 0xae, 0x0d, 0xe4, 0x23, 0x19, 0xad, 0xb8, 0x2b, 0x26, 0xaf,
+	0x70, 0xb7, 0xe7, 0xb0, 0x9a, 0xe7, 0xd6, 0x75, 0x4c, 0x78, 0x8e, 0xae, 0x0b, 0xee, 0xa9, 0x75,
+	0x30, 0xba, 0x28, 0xad, 0x5c, 0x7c, 0xf8, 0xf8, 0x98, 0xf4, 0x3b, 0xfc, 0xfe, 0x82, 0xdf, 0x5b,
+	0x4a, 0xde, 0x1f, 0x6d, 0x7b, 0xff, 0x90, 0xfc, 0x0f, 0x7b, 0x5a, 0x7d, 0xae, 0xa5, 0x1c, 0x00,
+	0x00,
 }
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -29,9 +30,11 @@ import (
 	"github.com/argoproj/argo-cd/test"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/assets"
+	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/session"
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
@@ -147,7 +150,7 @@ func newTestAppServer(objects ...runtime.Object) *Server {
 		kubeclientset,
 		fakeAppsClientset,
 		mockRepoClient,
-		nil,
+		cache.NewCache(cache.NewInMemoryCache(1*time.Hour)),
 		kube.KubectlCmd{},
 		db,
 		enforcer,
@@ -264,10 +267,28 @@ func TestDeleteApp(t *testing.T) {
 	assert.Nil(t, err)
 	assert.False(t, patched)
 	assert.True(t, deleted)
+
+	// the propagation policy is specified by the resources finalizer
+	var patch string
+	fakeAppCs.PrependReactor("patch", "applications", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patch = string(action.(kubetesting.PatchAction).GetPatch())
+		return true, nil, nil
+	})
+	orphan := "orphan"
+	_, err = appServer.Delete(ctx, &ApplicationDeleteRequest{Name: &app.Name, Cascade: &trueVar, PropagationPolicy: &orphan})
+	assert.Nil(t, err)
+	assert.Contains(t, patch, common.ResourcesFinalizerName+"/orphan")
+
+	invalid := "cascade"
+	_, err = appServer.Delete(ctx, &ApplicationDeleteRequest{Name: &app.Name, Cascade: &trueVar, PropagationPolicy: &invalid})
+	assert.Error(t, err)
+	_, err = appServer.Delete(ctx, &ApplicationDeleteRequest{Name: &app.Name, Cascade: &falseVar, PropagationPolicy: &orphan})
+	assert.Error(t, err)
 }
 
 func TestSyncAndTerminate(t *testing.T) {
 	ctx := context.Background()
+	ctx = context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: "admin", Issuer: session.SessionManagerClaimsIssuer})
 	appServer := newTestAppServer()
 	testApp := newTestApp()
 	testApp.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
@@ -281,6 +302,7 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, app)
 	assert.NotNil(t, app.Operation)
+	assert.Equal(t, "admin", app.Operation.InitiatedBy.Username)
 
 	events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(metav1.ListOptions{})
 	assert.Nil(t, err)
@@ -307,6 +329,39 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Equal(t, appsv1.OperationTerminating, app.Status.OperationState.Phase)
 }
 
+func TestSyncQueueAndCancel(t *testing.T) {
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: "admin", Issuer: session.SessionManagerClaimsIssuer})
+	appServer := newTestAppServer()
+	testApp := newTestApp()
+	testApp.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	app, err := appServer.Create(ctx, &ApplicationCreateRequest{Application: *testApp})
+	assert.Nil(t, err)
+
+	app, err = appServer.Sync(ctx, &ApplicationSyncRequest{Name: &app.Name, Queue: true})
+	assert.Nil(t, err)
+	assert.NotNil(t, app.Operation)
+	assert.Len(t, app.PendingOperations, 0)
+
+	// without queueing, a second sync is rejected
+	_, err = appServer.Sync(ctx, &ApplicationSyncRequest{Name: &app.Name, Prune: true})
+	assert.NotNil(t, err)
+
+	app, err = appServer.Sync(ctx, &ApplicationSyncRequest{Name: &app.Name, Prune: true, Queue: true})
+	assert.Nil(t, err)
+	assert.Len(t, app.PendingOperations, 1)
+
+	// identical requests are queued only once
+	app, err = appServer.Sync(ctx, &ApplicationSyncRequest{Name: &app.Name, Prune: true, Queue: true})
+	assert.Nil(t, err)
+	assert.Len(t, app.PendingOperations, 1)
+
+	app, err = appServer.CancelPendingOperation(ctx, &PendingOperationCancelRequest{Name: &app.Name, ID: app.PendingOperations[0].ID})
+	assert.Nil(t, err)
+	assert.Len(t, app.PendingOperations, 0)
+	assert.NotNil(t, app.Operation)
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []appsv1.RevisionHistory{{
@@ -329,6 +384,92 @@ func TestRollbackApp(t *testing.T) {
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
 }
 
+func TestRollbackAppToRevision(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+
+	revision := "0123456789abcdef0123456789abcdef01234567"
+	resolve := func(rev string) interface{} {
+		return mock.MatchedBy(func(req *repository.ResolveRevisionRequest) bool {
+			return req.Revision == rev && req.AncestorOf == util.FirstNonEmpty(testApp.Spec.Source.TargetRevision, "HEAD")
+		})
+	}
+	mockRepoServiceClient := mockreposerver.RepoServerServiceClient{}
+	mockRepoServiceClient.On("ResolveRevision", mock.Anything, resolve("0123456")).Return(&repository.ResolveRevisionResponse{Revision: revision}, nil)
+	mockRepoServiceClient.On("ResolveRevision", mock.Anything, resolve("feature")).Return(nil, status.Errorf(codes.InvalidArgument, "revision feature is not reachable from HEAD"))
+	mockRepoClient := &mockrepo.Clientset{}
+	mockRepoClient.On("NewRepoServerClient").Return(&fakeCloser{}, &mockRepoServiceClient, nil)
+	appServer.repoClientset = mockRepoClient
+
+	// commits which are not reachable from the tracked branch are rejected
+	_, err := appServer.Rollback(context.Background(), &ApplicationRollbackRequest{
+		Name:     &testApp.Name,
+		Revision: "feature",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	updatedApp, err := appServer.Rollback(context.Background(), &ApplicationRollbackRequest{
+		Name:     &testApp.Name,
+		Revision: "0123456",
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, updatedApp.Operation)
+	assert.Equal(t, revision, updatedApp.Operation.Sync.Revision)
+	assert.Equal(t, testApp.Spec.Source, *updatedApp.Operation.Sync.Source)
+}
+
+func TestHistoryDiff(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Status.History = []appsv1.RevisionHistory{
+		{ID: 1, Revision: "rev1", Source: *testApp.Spec.Source.DeepCopy()},
+		{ID: 2, Revision: "rev2", Source: *testApp.Spec.Source.DeepCopy()},
+	}
+	appServer := newTestAppServer(testApp)
+
+	fromPod := test.NewPod()
+	toPod := test.NewPod()
+	toPod.SetLabels(map[string]string{"version": "2"})
+	manifests := func(objs ...interface{}) []string {
+		res := make([]string, len(objs))
+		for i := range objs {
+			data, err := json.Marshal(objs[i])
+			errors.CheckError(err)
+			res[i] = string(data)
+		}
+		return res
+	}
+	revision := func(rev string) interface{} {
+		return mock.MatchedBy(func(req *repository.ManifestRequest) bool {
+			return req.Revision == rev
+		})
+	}
+	mockRepoServiceClient := mockreposerver.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, revision("rev1")).Return(&repository.ManifestResponse{Manifests: manifests(fromPod, test.NewService())}, nil)
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, revision("rev2")).Return(&repository.ManifestResponse{Manifests: manifests(toPod)}, nil)
+	mockRepoClient := &mockrepo.Clientset{}
+	mockRepoClient.On("NewRepoServerClient").Return(&fakeCloser{}, &mockRepoServiceClient, nil)
+	appServer.repoClientset = mockRepoClient
+
+	res, err := appServer.HistoryDiff(context.Background(), &ApplicationHistoryDiffQuery{Name: &testApp.Name, FromID: 1, ToID: 2})
+	assert.NoError(t, err)
+	assert.Len(t, res.Items, 2)
+	for _, item := range res.Items {
+		switch item.Kind {
+		case kube.PodKind:
+			assert.NotEqual(t, "null", item.LiveState)
+			assert.Contains(t, item.TargetState, `"version":"2"`)
+		case kube.ServiceKind:
+			assert.NotEqual(t, "null", item.LiveState)
+			assert.Equal(t, "null", item.TargetState)
+		default:
+			t.Errorf("unexpected resource %s", item.Kind)
+		}
+	}
+
+	_, err = appServer.HistoryDiff(context.Background(), &ApplicationHistoryDiffQuery{Name: &testApp.Name, FromID: 1, ToID: 3})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestUpdateAppProject(t *testing.T) {
 	testApp := newTestApp()
 	ctx := context.Background()
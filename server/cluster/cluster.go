@@ -72,6 +72,16 @@ func (s *Server) getConnectionState(ctx context.Context, cluster appv1.Cluster,
 	return connectionState
 }
 
+// getClusterInfo returns the cluster information reported by the application controller. The information is
+// empty if the cluster is not yet cached by the controller.
+func (s *Server) getClusterInfo(server string) appv1.ClusterInfo {
+	info, err := s.cache.GetClusterInfo(server)
+	if err != nil && err != cache.ErrCacheMiss {
+		log.Warnf("getClusterInfo cache get error %s: %v", server, err)
+	}
+	return info
+}
+
 // List returns list of clusters
 func (s *Server) List(ctx context.Context, q *ClusterQuery) (*appv1.ClusterList, error) {
 	clusterList, err := s.db.ListClusters(ctx)
@@ -100,6 +110,7 @@ func (s *Server) List(ctx context.Context, q *ClusterQuery) (*appv1.ClusterList,
 		if clust.ConnectionState.Status == "" {
 			clust.ConnectionState = s.getConnectionState(ctx, clust, warningMessage)
 		}
+		clust.Info = s.getClusterInfo(clust.Server)
 		items[i] = *redact(&clust)
 		return nil
 	})
@@ -117,6 +128,9 @@ func (s *Server) Create(ctx context.Context, q *ClusterCreateRequest) (*appv1.Cl
 		return nil, err
 	}
 	c := q.Cluster
+	if err := validateShard(c); err != nil {
+		return nil, err
+	}
 	err := kube.TestConfig(q.Cluster.RESTConfig())
 	if err != nil {
 		return nil, err
@@ -178,7 +192,7 @@ func (s *Server) CreateFromKubeConfig(ctx context.Context, q *ClusterCreateFromK
 		return nil, status.Errorf(codes.Internal, "Could not create Kubernetes clientset: %v", err)
 	}
 
-	bearerToken, err := common.InstallClusterManagerRBAC(clientset)
+	bearerToken, err := common.InstallClusterManagerRBAC(clientset, "kube-system", nil)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not install cluster manager RBAC: %v", err)
 	}
@@ -197,7 +211,11 @@ func (s *Server) Get(ctx context.Context, q *ClusterQuery) (*appv1.Cluster, erro
 		return nil, err
 	}
 	clust, err := s.db.GetCluster(ctx, q.Server)
-	return redact(clust), err
+	if err != nil {
+		return nil, err
+	}
+	clust.Info = s.getClusterInfo(clust.Server)
+	return redact(clust), nil
 }
 
 // Update updates a cluster
@@ -205,6 +223,9 @@ func (s *Server) Update(ctx context.Context, q *ClusterUpdateRequest) (*appv1.Cl
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, q.Cluster.Server); err != nil {
 		return nil, err
 	}
+	if err := validateShard(q.Cluster); err != nil {
+		return nil, err
+	}
 	err := kube.TestConfig(q.Cluster.RESTConfig())
 	if err != nil {
 		return nil, err
@@ -213,6 +234,15 @@ func (s *Server) Update(ctx context.Context, q *ClusterUpdateRequest) (*appv1.Cl
 	return redact(clust), err
 }
 
+// validateShard rejects negative shard numbers. Shards above the number of controller replicas cannot be detected here
+// and fall back to the hashed shard in the controller.
+func validateShard(c *appv1.Cluster) error {
+	if c.Shard != nil && *c.Shard < 0 {
+		return status.Errorf(codes.InvalidArgument, "shard must not be negative: %d", *c.Shard)
+	}
+	return nil
+}
+
 // Delete deletes a cluster by name
 func (s *Server) Delete(ctx context.Context, q *ClusterQuery) (*ClusterResponse, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionDelete, q.Server); err != nil {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"path"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"time"
 
@@ -31,10 +32,14 @@ import (
 	"github.com/argoproj/argo-cd/util/ksonnet"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/kustomize"
+	"github.com/argoproj/argo-cd/util/rand"
 )
 
 const (
 	errDestinationMissing = "Destination server and/or namespace missing from app spec"
+
+	pendingOperationIDLength  = 8
+	pendingOperationIDCharset = "abcdefghijklmnopqrstuvwxyz0123456789"
 )
 
 // FormatAppConditions returns string representation of give app condition list
@@ -134,6 +139,7 @@ func WaitForRefresh(ctx context.Context, appIf v1alpha1.ApplicationInterface, na
 // * the app source repo and destination namespace/cluster are permitted in app project
 // * there are parameters of only one app source type
 // * ksonnet: the specified environment exists
+// * the sync retry strategy is valid
 func GetSpecErrors(
 	ctx context.Context,
 	spec *argoappv1.ApplicationSpec,
@@ -180,6 +186,45 @@ func GetSpecErrors(
 		repoAccessable = true
 	}
 
+	if spec.SyncPolicy != nil && spec.SyncPolicy.Retry != nil {
+		if err := spec.SyncPolicy.Retry.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: err.Error(),
+			})
+		}
+	}
+	if spec.SyncPolicy != nil && spec.SyncPolicy.Automated != nil {
+		if err := spec.SyncPolicy.Automated.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: err.Error(),
+			})
+		}
+	}
+	if spec.SyncPolicy != nil && spec.SyncPolicy.HealthCheck != nil {
+		if _, err := spec.SyncPolicy.HealthCheck.GetTimeout(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: err.Error(),
+			})
+		}
+	}
+	if spec.SyncPolicy != nil && spec.SyncPolicy.Schedule != nil {
+		if err := spec.SyncPolicy.Schedule.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: err.Error(),
+			})
+		}
+	}
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 0 {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("revision history limit must not be negative, but was %d", *spec.RevisionHistoryLimit),
+		})
+	}
+
 	var appSourceType argoappv1.ApplicationSourceType
 	// Verify only one source type is defined
 	explicitSourceType, err := spec.Source.ExplicitType()
@@ -241,7 +286,7 @@ func GetSpecErrors(
 			})
 		}
 		// Ensure the k8s cluster the app is referencing, is configured in Argo CD
-		_, err = db.GetCluster(ctx, spec.Destination.Server)
+		cluster, err := db.GetCluster(ctx, spec.Destination.Server)
 		if err != nil {
 			if errStatus, ok := status.FromError(err); ok && errStatus.Code() == codes.NotFound {
 				conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -251,6 +296,11 @@ func GetSpecErrors(
 			} else {
 				return nil, "", err
 			}
+		} else if !cluster.IsNamespaceAccessible(spec.Destination.Namespace) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("namespace '%s' is not accessible in cluster '%s'", spec.Destination.Namespace, spec.Destination.Server),
+			})
 		}
 	}
 	return conditions, appSourceType, nil
@@ -436,6 +486,80 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 	}
 }
 
+// QueueAppOperation sets the operation of an application, or appends it to the queue of pending operations if
+// another operation is already in progress or queued. The returned pending operation is nil if the operation was started
+// right away. A sync operation identical to the operation which runs last, i.e. the last queued operation or the running
+// operation if nothing is queued, is not queued a second time. So the pending operation of a sync is always the last one
+// of the queue.
+func QueueAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *argoappv1.Operation) (*argoappv1.Application, *argoappv1.PendingOperation, error) {
+	if op.Sync == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "Operation unspecified")
+	}
+	for {
+		a, err := appIf.Get(appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		var pending *argoappv1.PendingOperation
+		if a.Operation == nil && len(a.PendingOperations) == 0 {
+			a.Operation = op
+			a.Status.OperationState = nil
+		} else if len(a.PendingOperations) == 0 && reflect.DeepEqual(a.Operation.Sync, op.Sync) {
+			// an identical operation is already running
+			return a, nil, nil
+		} else if len(a.PendingOperations) > 0 && reflect.DeepEqual(a.PendingOperations[len(a.PendingOperations)-1].Operation.Sync, op.Sync) {
+			return a, &a.PendingOperations[len(a.PendingOperations)-1], nil
+		} else {
+			a.PendingOperations = append(a.PendingOperations, argoappv1.PendingOperation{
+				ID:        rand.RandStringCharset(pendingOperationIDLength, pendingOperationIDCharset),
+				Operation: *op,
+				QueuedAt:  metav1.Now(),
+			})
+			pending = &a.PendingOperations[len(a.PendingOperations)-1]
+		}
+		updated, err := appIf.Update(a)
+		if err == nil {
+			if pending != nil {
+				pending = &updated.PendingOperations[len(updated.PendingOperations)-1]
+			}
+			return updated, pending, nil
+		}
+		if !apierr.IsConflict(err) {
+			return nil, nil, err
+		}
+		log.Warnf("Failed to queue operation for app '%s' due to update conflict. Retrying again...", appName)
+	}
+}
+
+// CancelPendingOperation removes the pending operation with the given ID from the queue of an application
+func CancelPendingOperation(appIf v1alpha1.ApplicationInterface, appName string, id string) (*argoappv1.Application, error) {
+	for {
+		a, err := appIf.Get(appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		index := -1
+		for i := range a.PendingOperations {
+			if a.PendingOperations[i].ID == id {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, status.Errorf(codes.NotFound, "pending operation '%s' not found", id)
+		}
+		a.PendingOperations = append(a.PendingOperations[:index], a.PendingOperations[index+1:]...)
+		a, err = appIf.Update(a)
+		if err == nil {
+			return a, nil
+		}
+		if !apierr.IsConflict(err) {
+			return nil, err
+		}
+		log.Warnf("Failed to cancel pending operation of app '%s' due to update conflict. Retrying again...", appName)
+	}
+}
+
 // ContainsSyncResource determines if the given resource exists in the provided slice of sync operation resources.
 func ContainsSyncResource(name string, gvk schema.GroupVersionKind, rr []argoappv1.SyncOperationResource) bool {
 	for _, r := range rr {
@@ -133,3 +133,64 @@ func TestNilOutZerValueAppSources(t *testing.T) {
 		assert.Nil(t, spec.Source.Directory)
 	}
 }
+
+func TestQueueAppOperation(t *testing.T) {
+	var testApp argoappv1.Application
+	testApp.Name = "test-app"
+	testApp.Namespace = "default"
+	appIf := appclientset.NewSimpleClientset(&testApp).ArgoprojV1alpha1().Applications("default")
+
+	// no operation in progress, the operation is started right away
+	app, pending, err := QueueAppOperation(appIf, "test-app", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}})
+	assert.NoError(t, err)
+	assert.Nil(t, pending)
+	assert.Equal(t, "aaa", app.Operation.Sync.Revision)
+
+	app, pending, err = QueueAppOperation(appIf, "test-app", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}})
+	assert.NoError(t, err)
+	assert.NotNil(t, pending)
+	assert.NotEmpty(t, pending.ID)
+	assert.Len(t, app.PendingOperations, 1)
+
+	// identical sync requests are de-duplicated
+	app, duplicate, err := QueueAppOperation(appIf, "test-app", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}})
+	assert.NoError(t, err)
+	assert.Equal(t, pending.ID, duplicate.ID)
+	assert.Len(t, app.PendingOperations, 1)
+
+	// the running operation is followed by a different queued operation, so the sync is queued again
+	app, requeued, err := QueueAppOperation(appIf, "test-app", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}})
+	assert.NoError(t, err)
+	assert.NotNil(t, requeued)
+	assert.Len(t, app.PendingOperations, 2)
+	_, err = CancelPendingOperation(appIf, "test-app", requeued.ID)
+	assert.NoError(t, err)
+
+	_, err = CancelPendingOperation(appIf, "test-app", "does-not-exist")
+	assert.Error(t, err)
+
+	app, err = CancelPendingOperation(appIf, "test-app", pending.ID)
+	assert.NoError(t, err)
+	assert.Len(t, app.PendingOperations, 0)
+	assert.Equal(t, "aaa", app.Operation.Sync.Revision)
+}
+
+func TestQueueAppOperationRunningDuplicate(t *testing.T) {
+	var testApp argoappv1.Application
+	testApp.Name = "test-app"
+	testApp.Namespace = "default"
+	testApp.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}}
+	appIf := appclientset.NewSimpleClientset(&testApp).ArgoprojV1alpha1().Applications("default")
+
+	// a sync identical to the running operation is not queued
+	app, pending, err := QueueAppOperation(appIf, "test-app", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}})
+	assert.NoError(t, err)
+	assert.Nil(t, pending)
+	assert.Len(t, app.PendingOperations, 0)
+	assert.Equal(t, "aaa", app.Operation.Sync.Revision)
+
+	app, pending, err = QueueAppOperation(appIf, "test-app", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}})
+	assert.NoError(t, err)
+	assert.NotNil(t, pending)
+	assert.Len(t, app.PendingOperations, 1)
+}
@@ -31,6 +31,7 @@ const (
 	EventReasonResourceDeleted    = "ResourceDeleted"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonPruneLimitExceeded = "PruneLimitExceeded"
 )
 
 func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersionKind, info EventInfo, message string) {
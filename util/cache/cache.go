@@ -21,6 +21,7 @@ const (
 	appStateCacheExpiration         = 1 * time.Hour
 	repoCacheExpiration             = 24 * time.Hour
 	oidcCacheExpiration             = 3 * time.Minute
+	clusterInfoCacheExpiration      = 10 * time.Minute
 
 	// envRedisPassword is a env variable name which stores redis password
 	envRedisPassword = "REDIS_PASSWORD"
@@ -88,6 +89,10 @@ func clusterConnectionStateKey(server string) string {
 	return fmt.Sprintf("cluster|%s|connection-state", server)
 }
 
+func clusterInfoKey(server string) string {
+	return fmt.Sprintf("cluster|%s|info", server)
+}
+
 func repoConnectionStateKey(repo string) string {
 	return fmt.Sprintf("repo|%s|connection-state", repo)
 }
@@ -162,6 +167,16 @@ func (c *Cache) SetClusterConnectionState(server string, state *appv1.Connection
 	return c.setItem(clusterConnectionStateKey(server), &state, connectionStatusCacheExpiration, state == nil)
 }
 
+func (c *Cache) GetClusterInfo(server string) (appv1.ClusterInfo, error) {
+	res := appv1.ClusterInfo{}
+	err := c.getItem(clusterInfoKey(server), &res)
+	return res, err
+}
+
+func (c *Cache) SetClusterInfo(server string, info *appv1.ClusterInfo) error {
+	return c.setItem(clusterInfoKey(server), &info, clusterInfoCacheExpiration, info == nil)
+}
+
 func (c *Cache) GetRepoConnectionState(repo string) (appv1.ConnectionState, error) {
 	res := appv1.ConnectionState{}
 	err := c.getItem(repoConnectionStateKey(repo), &res)
@@ -0,0 +1,218 @@
+// Package cron implements parsing of standard five field cron expressions
+// (minute, hour, day of month, month, day of week).
+//
+// Sync windows and sync schedules both need the most recent activation before a given time
+// (to decide whether a window of some duration is currently open), which the established
+// libraries such as robfig/cron do not provide: they only compute the next activation. This
+// package implements both directions over the same parsed schedule, evaluated in the location
+// of the time it is given.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearchYears bounds the search for the next activation time, so impossible schedules
+// (e.g. February 30th) do not loop forever
+const maxSearchYears = 5
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type bounds struct {
+	min, max int
+}
+
+var (
+	minuteBounds = bounds{0, 59}
+	hourBounds   = bounds{0, 23}
+	domBounds    = bounds{1, 31}
+	monthBounds  = bounds{1, 12}
+	dowBounds    = bounds{0, 7}
+)
+
+// Schedule is a parsed cron expression
+type Schedule struct {
+	minute, hour, dom, month, dow map[int]bool
+	// domStar and dowStar indicate the day of month and day of week fields are unrestricted.
+	// If both fields are restricted, a day matches if either field matches.
+	domStar, dowStar bool
+}
+
+// Parse parses a standard five field cron expression or one of the predefined descriptors
+// (e.g. @daily)
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if expanded, ok := descriptors[spec]; ok {
+		spec = expanded
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression '%s': expected 5 fields, found %d", spec, len(fields))
+	}
+	var s Schedule
+	var err error
+	if s.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, fmt.Errorf("invalid cron expression '%s': minute: %v", spec, err)
+	}
+	if s.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, fmt.Errorf("invalid cron expression '%s': hour: %v", spec, err)
+	}
+	if s.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, fmt.Errorf("invalid cron expression '%s': day of month: %v", spec, err)
+	}
+	if s.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, fmt.Errorf("invalid cron expression '%s': month: %v", spec, err)
+	}
+	if s.dow, err = parseField(fields[4], dowBounds); err != nil {
+		return nil, fmt.Errorf("invalid cron expression '%s': day of week: %v", spec, err)
+	}
+	// both 0 and 7 are Sunday
+	if s.dow[7] {
+		s.dow[0] = true
+	}
+	s.domStar = strings.HasPrefix(fields[2], "*")
+	s.dowStar = strings.HasPrefix(fields[4], "*")
+	return &s, nil
+}
+
+// parseField parses a comma separated list of values, ranges (1-5) and steps (*/5, 1-30/5)
+func parseField(field string, b bounds) (map[int]bool, error) {
+	values := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		rangePart := part
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step '%s'", part[i+1:])
+			}
+			rangePart = part[:i]
+		}
+		start, end := b.min, b.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			rangeParts := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if start, err = parseValue(rangeParts[0], b); err != nil {
+				return nil, err
+			}
+			if end, err = parseValue(rangeParts[1], b); err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, fmt.Errorf("invalid range '%s'", rangePart)
+			}
+		default:
+			var err error
+			if start, err = parseValue(rangePart, b); err != nil {
+				return nil, err
+			}
+			if step == 1 {
+				end = start
+			}
+		}
+		for v := start; v <= end; v += step {
+			values[v] = true
+		}
+	}
+	return values, nil
+}
+
+func parseValue(value string, b bounds) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s'", value)
+	}
+	if v < b.min || v > b.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, b.min, b.max)
+	}
+	return v, nil
+}
+
+// Next returns the first activation time of the schedule strictly after the given time, in the
+// location of the given time. Returns the zero time if there is no activation time.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc).Add(time.Minute)
+	yearLimit := t.Year() + maxSearchYears
+	for t.Year() <= yearLimit {
+		if !s.month[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.hour[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if !s.minute[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// Prev returns the last activation time of the schedule at or before the given time, searching
+// back no further than the given time minus the lookback duration. Returns the zero time if there
+// is no such activation time.
+func (s *Schedule) Prev(t time.Time, lookback time.Duration) time.Time {
+	loc := t.Location()
+	earliest := t.Add(-lookback).Truncate(time.Minute)
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+	for !t.Before(earliest) {
+		if !s.month[int(t.Month())] {
+			t = stepBack(t, time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = stepBack(t, time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc))
+			continue
+		}
+		if !s.hour[t.Hour()] {
+			t = stepBack(t, time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc))
+			continue
+		}
+		if !s.minute[t.Minute()] {
+			t = t.Add(-time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// stepBack returns the last minute before the start of the period containing t. Falls back to the
+// minute before t if the start is ambiguous (e.g. during a daylight saving time transition).
+func stepBack(t time.Time, start time.Time) time.Time {
+	if start.After(t) {
+		return t.Add(-time.Minute)
+	}
+	return start.Add(-time.Minute)
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom[t.Day()]
+	dowMatch := s.dow[int(t.Weekday())]
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,85 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	for _, spec := range []string{"* * * * *", "*/5 1-3 1,15 * 1-5", "0 22 * * 7", "@daily", "30 4 1-10/3 2 *"} {
+		_, err := Parse(spec)
+		assert.NoError(t, err, spec)
+	}
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestNext(t *testing.T) {
+	now := time.Date(2019, 5, 31, 23, 30, 10, 0, time.UTC)
+	tests := []struct {
+		spec string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2019, 5, 31, 23, 31, 0, 0, time.UTC)},
+		{"0 * * * *", time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 22 * * *", time.Date(2019, 6, 1, 22, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)},
+		// 2019-06-03 is a Monday
+		{"15 10 * * 1", time.Date(2019, 6, 3, 10, 15, 0, 0, time.UTC)},
+		// either day of month or day of week matches when both are restricted
+		{"0 0 15 * 1", time.Date(2019, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, tt := range tests {
+		s, err := Parse(tt.spec)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.next, s.Next(now), tt.spec)
+	}
+}
+
+func TestNextInLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	s, err := Parse("0 22 * * *")
+	assert.NoError(t, err)
+	next := s.Next(time.Date(2019, 6, 1, 19, 0, 0, 0, time.UTC).In(loc))
+	assert.Equal(t, time.Date(2019, 6, 1, 20, 0, 0, 0, time.UTC), next.UTC())
+}
+
+func TestPrev(t *testing.T) {
+	s, err := Parse("0 22 * * *")
+	assert.NoError(t, err)
+	now := time.Date(2019, 6, 1, 22, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2019, 6, 1, 22, 0, 0, 0, time.UTC), s.Prev(now, time.Hour))
+	assert.True(t, s.Prev(now, 10*time.Minute).IsZero())
+}
+
+func TestPrevMatchesNext(t *testing.T) {
+	now := time.Date(2019, 3, 1, 12, 7, 30, 0, time.UTC)
+	for _, spec := range []string{"* * * * *", "*/15 * * * *", "0 22 * * *", "30 4 1-10/3 2 *", "0 0 * * 0", "@monthly", "5 1 29 2 *"} {
+		s, err := Parse(spec)
+		assert.NoError(t, err)
+		for _, lookback := range []time.Duration{0, time.Hour, 24 * time.Hour, 31 * 24 * time.Hour, 400 * 24 * time.Hour} {
+			var expected time.Time
+			for next := s.Next(now.Add(-lookback - time.Minute)); !next.IsZero() && !next.After(now); next = s.Next(next) {
+				expected = next
+			}
+			assert.Equal(t, expected, s.Prev(now, lookback), "%s with lookback %v", spec, lookback)
+		}
+	}
+}
+
+func TestPrevInLocation(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	s, err := Parse("30 2 * * *")
+	assert.NoError(t, err)
+	// 2:30 does not exist on the day daylight saving time starts
+	now := time.Date(2019, 3, 31, 12, 0, 0, 0, loc)
+	assert.Equal(t, time.Date(2019, 3, 30, 2, 30, 0, 0, loc), s.Prev(now, 48*time.Hour))
+	assert.True(t, s.Prev(now, 12*time.Hour).IsZero())
+}
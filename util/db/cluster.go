@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"hash/fnv"
 	"net/url"
+	"strconv"
 	"strings"
 
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -256,6 +258,12 @@ func clusterToData(c *appv1.Cluster) map[string][]byte {
 		panic(err)
 	}
 	data["config"] = configBytes
+	if c.Shard != nil {
+		data["shard"] = []byte(strconv.FormatInt(*c.Shard, 10))
+	}
+	if len(c.Namespaces) > 0 {
+		data["namespaces"] = []byte(strings.Join(c.Namespaces, ","))
+	}
 	return data
 }
 
@@ -271,5 +279,20 @@ func secretToCluster(s *apiv1.Secret) *appv1.Cluster {
 		Name:   string(s.Data["name"]),
 		Config: config,
 	}
+	if shardStr, ok := s.Data["shard"]; ok {
+		shard, err := strconv.ParseInt(string(shardStr), 10, 64)
+		if err != nil {
+			log.Warnf("Ignoring invalid shard %q of cluster %s: %v", string(shardStr), cluster.Server, err)
+		} else {
+			cluster.Shard = &shard
+		}
+	}
+	if namespacesStr, ok := s.Data["namespaces"]; ok {
+		for _, ns := range strings.Split(string(namespacesStr), ",") {
+			if ns = strings.TrimSpace(ns); ns != "" {
+				cluster.Namespaces = append(cluster.Namespaces, ns)
+			}
+		}
+	}
 	return &cluster
 }
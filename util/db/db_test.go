@@ -254,6 +254,49 @@ func TestCreateClusterSuccessful(t *testing.T) {
 	assert.Equal(t, common.AnnotationValueManagedByArgoCD, secret.Annotations[common.AnnotationKeyManagedBy])
 }
 
+func TestCreateClusterWithShard(t *testing.T) {
+	clusterURL := "https://mycluster"
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	shard := int64(2)
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server: clusterURL,
+		Shard:  &shard,
+	})
+	assert.Nil(t, err)
+
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get("cluster-mycluster-3274446258", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", string(secret.Data["shard"]))
+
+	cluster, err := db.GetCluster(context.Background(), clusterURL)
+	assert.Nil(t, err)
+	if assert.NotNil(t, cluster.Shard) {
+		assert.Equal(t, shard, *cluster.Shard)
+	}
+}
+
+func TestCreateClusterWithNamespaces(t *testing.T) {
+	clusterURL := "https://mycluster"
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server:     clusterURL,
+		Namespaces: []string{"ns1", "ns2"},
+	})
+	assert.Nil(t, err)
+
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get("cluster-mycluster-3274446258", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "ns1,ns2", string(secret.Data["namespaces"]))
+
+	cluster, err := db.GetCluster(context.Background(), clusterURL)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ns1", "ns2"}, cluster.Namespaces)
+}
+
 func TestDeleteClusterWithManagedSecret(t *testing.T) {
 	clusterURL := "https://mycluster"
 	clusterName := "cluster-mycluster-3274446258"
@@ -15,6 +15,7 @@ import (
 	"k8s.io/kubernetes/pkg/apis/core"
 	"k8s.io/kubernetes/pkg/kubectl/scheme"
 
+	"github.com/argoproj/argo-cd/common"
 	jsonutil "github.com/argoproj/argo-cd/util/json"
 )
 
@@ -32,9 +33,17 @@ type Normalizer interface {
 	Normalize(un *unstructured.Unstructured) (*unstructured.Unstructured, error)
 }
 
-// Diff performs a diff on two unstructured objects. If the live object happens to have a
+// Diff performs a diff on two unstructured objects. If the live object has fields owned by the
+// Argo CD field manager (i.e. it was synced with server-side apply), then the field ownership is
+// used to perform the diff. Otherwise, if the live object happens to have a
 // "kubectl.kubernetes.io/last-applied-configuration", then perform a three way diff.
 func Diff(config, live *unstructured.Unstructured, normalizer Normalizer) *DiffResult {
+	return DiffWithFieldManager(config, live, normalizer, common.ArgoCDFieldManager)
+}
+
+// DiffWithFieldManager performs a diff like Diff, but uses the field ownership of the given field
+// manager. The default Argo CD field manager is used if fieldManager is empty.
+func DiffWithFieldManager(config, live *unstructured.Unstructured, normalizer Normalizer, fieldManager string) *DiffResult {
 	if config != nil {
 		config = stripTypeInformation(config)
 		Normalize(config, normalizer)
@@ -43,6 +52,14 @@ func Diff(config, live *unstructured.Unstructured, normalizer Normalizer) *DiffR
 		live = stripTypeInformation(live)
 		Normalize(live, normalizer)
 	}
+	if fieldManager == "" {
+		fieldManager = common.ArgoCDFieldManager
+	}
+	if config != nil {
+		if fieldSet := GetManagedFields(live, fieldManager); fieldSet != nil {
+			return ManagedFieldsDiff(config, live, fieldSet)
+		}
+	}
 	orig := GetLastAppliedConfigAnnotation(live)
 	if orig != nil && config != nil {
 		Normalize(orig, normalizer)
@@ -211,7 +228,7 @@ func GetLastAppliedConfigAnnotation(live *unstructured.Unstructured) *unstructur
 
 // DiffArray performs a diff on a list of unstructured objects. Objects are expected to match
 // environments
-func DiffArray(configArray, liveArray []*unstructured.Unstructured, normalizer Normalizer) (*DiffResultList, error) {
+func DiffArray(configArray, liveArray []*unstructured.Unstructured, normalizer Normalizer, fieldManager string) (*DiffResultList, error) {
 	numItems := len(configArray)
 	if len(liveArray) != numItems {
 		return nil, fmt.Errorf("left and right arrays have mismatched lengths")
@@ -223,7 +240,7 @@ func DiffArray(configArray, liveArray []*unstructured.Unstructured, normalizer N
 	for i := 0; i < numItems; i++ {
 		config := configArray[i]
 		live := liveArray[i]
-		diffRes := Diff(config, live, normalizer)
+		diffRes := DiffWithFieldManager(config, live, normalizer, fieldManager)
 		diffResultList.Diffs[i] = *diffRes
 		if diffRes.Modified {
 			diffResultList.Modified = true
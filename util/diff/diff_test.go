@@ -104,7 +104,7 @@ func TestDiffArraySame(t *testing.T) {
 
 	left := []*unstructured.Unstructured{leftUn}
 	right := []*unstructured.Unstructured{rightUn}
-	diffResList, err := DiffArray(left, right, nil)
+	diffResList, err := DiffArray(left, right, nil, "")
 	assert.Nil(t, err)
 	assert.False(t, diffResList.Modified)
 }
@@ -119,7 +119,7 @@ func TestDiffArrayAdditions(t *testing.T) {
 
 	left := []*unstructured.Unstructured{leftUn}
 	right := []*unstructured.Unstructured{rightUn}
-	diffResList, err := DiffArray(left, right, nil)
+	diffResList, err := DiffArray(left, right, nil, "")
 	assert.Nil(t, err)
 	assert.False(t, diffResList.Modified)
 }
@@ -135,7 +135,7 @@ func TestDiffArrayModification(t *testing.T) {
 
 	left := []*unstructured.Unstructured{leftUn}
 	right := []*unstructured.Unstructured{rightUn}
-	diffResList, err := DiffArray(left, right, nil)
+	diffResList, err := DiffArray(left, right, nil, "")
 	assert.Nil(t, err)
 	assert.True(t, diffResList.Modified)
 }
@@ -587,3 +587,112 @@ func TestHideSecretDataLastAppliedConfig(t *testing.T) {
 	assert.Equal(t, map[string]interface{}{"key1": replacement3}, secretData(lastAppliedSecret))
 
 }
+
+var managedFieldsLiveObj = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-deployment
+  labels:
+    app: my-app
+    owner: someone-else
+  managedFields:
+  - manager: argocd-controller
+    operation: Apply
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:metadata:
+        f:labels:
+          f:app: {}
+      f:spec:
+        f:replicas: {}
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"main"}:
+                .: {}
+                f:image: {}
+                f:name: {}
+  - manager: kube-controller-manager
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:metadata:
+        f:labels:
+          f:owner: {}
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: main
+        image: nginx:1.15
+      - name: sidecar
+        image: envoy
+`
+
+func TestManagedFieldsDiff(t *testing.T) {
+	var live unstructured.Unstructured
+	err := yaml.Unmarshal([]byte(managedFieldsLiveObj), &live)
+	assert.NoError(t, err)
+	live = *stripTypeInformation(&live)
+
+	fieldSet := GetManagedFields(&live, "argocd-controller")
+	assert.NotNil(t, fieldSet)
+	assert.Nil(t, GetManagedFields(&live, "unknown"))
+
+	config := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":   "my-deployment",
+			"labels": map[string]interface{}{"app": "my-app"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": float64(2),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "main", "image": "nginx:1.15"},
+					},
+				},
+			},
+		},
+	}}
+	// the list of containers is part of the config, so the extra sidecar container is reported
+	diffRes := ManagedFieldsDiff(&config, &live, fieldSet)
+	assert.True(t, diffRes.Modified)
+
+	// fields owned by other managers (e.g. the owner label) are ignored
+	unstructured.RemoveNestedField(config.Object, "spec", "template")
+	unstructured.RemoveNestedField(live.Object, "spec", "template")
+	diffRes = ManagedFieldsDiff(&config, &live, fieldSet)
+	assert.False(t, diffRes.Modified)
+
+	// fields owned by Argo CD but removed from the config are reported
+	unstructured.RemoveNestedField(config.Object, "spec", "replicas")
+	diffRes = ManagedFieldsDiff(&config, &live, fieldSet)
+	assert.True(t, diffRes.Modified)
+}
+
+func TestDiffWithFieldManager(t *testing.T) {
+	var live unstructured.Unstructured
+	err := yaml.Unmarshal([]byte(strings.Replace(managedFieldsLiveObj, "manager: argocd-controller", "manager: custom-manager", 1)), &live)
+	assert.NoError(t, err)
+	unstructured.RemoveNestedField(live.Object, "spec", "template")
+	config := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":   "my-deployment",
+			"labels": map[string]interface{}{"app": "my-app"},
+		},
+	}}
+
+	// the replicas owned by the custom field manager were removed from the config
+	assert.True(t, DiffWithFieldManager(&config, &live, nil, "custom-manager").Modified)
+	// without field ownership the extra replicas field of the live object is ignored
+	assert.False(t, DiffWithFieldManager(&config, &live, nil, "").Modified)
+}
@@ -0,0 +1,170 @@
+package diff
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/yudai/gojsondiff"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	jsonutil "github.com/argoproj/argo-cd/util/json"
+)
+
+// GetManagedFields returns the set of fields (in the managedFields format of server-side apply)
+// which are owned by the given field manager in the live object. Returns nil if the field manager
+// does not own any field.
+func GetManagedFields(live *unstructured.Unstructured, manager string) map[string]interface{} {
+	if live == nil {
+		return nil
+	}
+	entries, ok, err := unstructured.NestedSlice(live.Object, "metadata", "managedFields")
+	if err != nil || !ok {
+		return nil
+	}
+	var fieldSet map[string]interface{}
+	for _, entryIf := range entries {
+		entry, ok := entryIf.(map[string]interface{})
+		if !ok || entry["manager"] != manager {
+			continue
+		}
+		// fields were renamed to fieldsV1 in Kubernetes v1.16
+		fields, ok := entry["fieldsV1"].(map[string]interface{})
+		if !ok {
+			fields, ok = entry["fields"].(map[string]interface{})
+		}
+		if ok {
+			fieldSet = mergeFieldSets(fieldSet, fields)
+		}
+	}
+	return fieldSet
+}
+
+// ManagedFieldsDiff performs a diff which takes the field ownership of server-side apply into
+// account. Fields owned by the field manager are compared with the config even if they are not
+// part of the config anymore (i.e. they are about to be removed), while fields owned only by other
+// field managers are ignored.
+// Inputs are assumed to be stripped of type information
+func ManagedFieldsDiff(config, live *unstructured.Unstructured, fieldSet map[string]interface{}) *DiffResult {
+	config = removeNamespaceAnnotation(config)
+	owned, _ := filterOwnedFields(live.Object, fieldSet).(map[string]interface{})
+	liveObj := mergeMaps(jsonutil.RemoveMapFields(config.Object, live.Object), owned)
+	liveObj = removeNamespaceAnnotation(&unstructured.Unstructured{Object: liveObj}).Object
+	gjDiff := gojsondiff.New().CompareObjects(liveObj, config.Object)
+	return &DiffResult{
+		Diff:     gjDiff,
+		Modified: gjDiff.Modified(),
+	}
+}
+
+// filterOwnedFields returns only the parts of the live value which are present in the field set
+func filterOwnedFields(live interface{}, fieldSet map[string]interface{}) interface{} {
+	switch typedLive := live.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{})
+		for k, v := range typedLive {
+			if childSet, ok := fieldSet["f:"+k]; ok {
+				result[k] = filterOwnedChild(v, childSet)
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0)
+		for i, item := range typedLive {
+			if childSet, ok := listItemFieldSet(item, i, fieldSet); ok {
+				result = append(result, filterOwnedChild(item, childSet))
+			}
+		}
+		return result
+	default:
+		return live
+	}
+}
+
+// filterOwnedChild keeps the whole value if the field set is a leaf, or filters it otherwise
+func filterOwnedChild(live interface{}, childSetIf interface{}) interface{} {
+	childSet, ok := childSetIf.(map[string]interface{})
+	if !ok {
+		return live
+	}
+	for k := range childSet {
+		if k != "." {
+			return filterOwnedFields(live, childSet)
+		}
+	}
+	return live
+}
+
+// listItemFieldSet returns the field set of a list item. List items are either identified by their
+// keys (k:), by their value (v:) or by their index (i:).
+func listItemFieldSet(item interface{}, index int, fieldSet map[string]interface{}) (interface{}, bool) {
+	for k, childSet := range fieldSet {
+		switch {
+		case strings.HasPrefix(k, "k:"):
+			var keys map[string]interface{}
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(k, "k:")), &keys); err != nil {
+				continue
+			}
+			itemMap, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			matches := true
+			for key, val := range keys {
+				if !reflect.DeepEqual(itemMap[key], val) {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				return childSet, true
+			}
+		case strings.HasPrefix(k, "v:"):
+			var val interface{}
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(k, "v:")), &val); err == nil && reflect.DeepEqual(item, val) {
+				return childSet, true
+			}
+		case strings.HasPrefix(k, "i:"):
+			if i, err := strconv.Atoi(strings.TrimPrefix(k, "i:")); err == nil && i == index {
+				return childSet, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// mergeFieldSets returns the union of two field sets
+func mergeFieldSets(a, b map[string]interface{}) map[string]interface{} {
+	if a == nil {
+		a = make(map[string]interface{})
+	}
+	for k, bVal := range b {
+		aMap, aOk := a[k].(map[string]interface{})
+		bMap, bOk := bVal.(map[string]interface{})
+		if aOk && bOk {
+			a[k] = mergeFieldSets(aMap, bMap)
+		} else if _, ok := a[k]; !ok {
+			a[k] = bVal
+		}
+	}
+	return a
+}
+
+// mergeMaps returns the union of two maps. Values of the first map take precedence.
+func mergeMaps(a, b map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range b {
+		result[k] = v
+	}
+	for k, aVal := range a {
+		aMap, aOk := aVal.(map[string]interface{})
+		bMap, bOk := result[k].(map[string]interface{})
+		if aOk && bOk {
+			result[k] = mergeMaps(aMap, bMap)
+		} else {
+			result[k] = aVal
+		}
+	}
+	return result
+}
@@ -26,6 +26,7 @@ type Client interface {
 	LsRemote(revision string) (string, error)
 	LsFiles(path string) ([]string, error)
 	CommitSHA() (string, error)
+	IsAncestor(commit, revision string) (bool, error)
 }
 
 // ClientFactory is a factory of Git Clients
@@ -241,6 +242,16 @@ func (m *nativeGitClient) CommitSHA() (string, error) {
 	return strings.TrimSpace(out), nil
 }
 
+// IsAncestor returns whether the commit is reachable from the revision, i.e. whether it is the
+// revision itself or one of its ancestors. Both have to be fetched.
+func (m *nativeGitClient) IsAncestor(commit, revision string) (bool, error) {
+	out, err := m.runCmd("git", "merge-base", commit, revision)
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(out) == commit, nil
+}
+
 // runCmd is a convenience function to run a command in a given directory and return its output
 func (m *nativeGitClient) runCmd(command string, args ...string) (string, error) {
 	cmd := exec.Command(command, args...)
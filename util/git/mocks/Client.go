@@ -72,6 +72,27 @@ func (_m *Client) Init() error {
 	return r0
 }
 
+// IsAncestor provides a mock function with given fields: commit, revision
+func (_m *Client) IsAncestor(commit string, revision string) (bool, error) {
+	ret := _m.Called(commit, revision)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(commit, revision)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(commit, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LsFiles provides a mock function with given fields: path
 func (_m *Client) LsFiles(path string) ([]string, error) {
 	ret := _m.Called(path)
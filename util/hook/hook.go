@@ -47,7 +47,8 @@ func IsArgoHook(obj *unstructured.Unstructured) bool {
 	for _, hookType := range resHookTypes {
 		hookType = strings.TrimSpace(hookType)
 		switch argoappv1.HookType(hookType) {
-		case argoappv1.HookTypePreSync, argoappv1.HookTypeSync, argoappv1.HookTypePostSync:
+		case argoappv1.HookTypePreSync, argoappv1.HookTypeSync, argoappv1.HookTypePostSync, argoappv1.HookTypeSyncFail,
+			argoappv1.HookTypePreDelete, argoappv1.HookTypePostDelete:
 			return true
 		}
 	}
@@ -0,0 +1,532 @@
+package kube
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/util/openapi"
+	openapivalidation "k8s.io/kubernetes/pkg/kubectl/cmd/util/openapi/validation"
+	"k8s.io/kubernetes/pkg/kubectl/scheme"
+
+	"github.com/argoproj/argo-cd/common"
+)
+
+const (
+	// applyPatchType is the patch type of server-side apply requests
+	applyPatchType types.PatchType = "application/apply-patch+yaml"
+	// maxPatchRetry is the number of times a patch is retried after a conflict, same as kubectl
+	maxPatchRetry = 5
+	// recreateTimeout is how long to wait for a force-deleted resource to disappear before recreating it
+	recreateTimeout = 1 * time.Minute
+	// openAPISchemaExpiration is how long the OpenAPI schema of a cluster is used for validation before
+	// it is downloaded again
+	openAPISchemaExpiration = 10 * time.Minute
+)
+
+// InProcessKubectl is a Kubectl implementation which applies resources with client-go dynamic
+// clients instead of spawning a kubectl process for every resource. Apply follows the same
+// semantics as `kubectl apply`: a three-way merge between the last-applied-configuration
+// annotation, the desired state and the live state.
+type InProcessKubectl struct {
+	KubectlCmd
+
+	lock    *sync.Mutex
+	clients map[string]*clusterClients
+}
+
+// clusterClients holds the clients of a cluster, which are reused by all applies to the cluster
+type clusterClients struct {
+	config     *rest.Config
+	dynamicIf  dynamic.Interface
+	disco      discovery.DiscoveryInterface
+	restClient *rest.RESTClient
+
+	lock             *sync.Mutex
+	openAPIResources openapi.Resources
+	openAPIFetchedAt time.Time
+}
+
+// NewInProcessKubectl returns a new in-process Kubectl implementation
+func NewInProcessKubectl() *InProcessKubectl {
+	return &InProcessKubectl{
+		lock:    &sync.Mutex{},
+		clients: make(map[string]*clusterClients),
+	}
+}
+
+// getClients returns the clients of the cluster of the given REST config. The clients are created on
+// first use, and again whenever the REST config of the cluster changes.
+func (k *InProcessKubectl) getClients(config *rest.Config) (*clusterClients, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if clients, ok := k.clients[config.Host]; ok && reflect.DeepEqual(clients.config, config) {
+		return clients, nil
+	}
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	restClient, err := newRawRESTClient(config)
+	if err != nil {
+		return nil, err
+	}
+	clients := &clusterClients{
+		config:     rest.CopyConfig(config),
+		dynamicIf:  dynamicIf,
+		disco:      disco,
+		restClient: restClient,
+		lock:       &sync.Mutex{},
+	}
+	k.clients[config.Host] = clients
+	return clients, nil
+}
+
+// getOpenAPIResources returns the OpenAPI schema of the cluster, which is downloaded at most once per
+// openAPISchemaExpiration
+func (c *clusterClients) getOpenAPIResources() (openapi.Resources, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.openAPIResources != nil && time.Since(c.openAPIFetchedAt) < openAPISchemaExpiration {
+		return c.openAPIResources, nil
+	}
+	doc, err := c.disco.OpenAPISchema()
+	if err != nil {
+		return nil, err
+	}
+	resources, err := openapi.NewOpenAPIData(doc)
+	if err != nil {
+		return nil, err
+	}
+	c.openAPIResources = resources
+	c.openAPIFetchedAt = time.Now()
+	return resources, nil
+}
+
+// ApplyResource performs an in-process apply of a unstructured resource. If validate is true, the
+// resource is validated against the OpenAPI schema of the cluster, same as `kubectl apply --validate`.
+func (k *InProcessKubectl) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error) {
+	log.Infof("Applying resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
+	clients, err := k.getClients(config)
+	if err != nil {
+		return "", err
+	}
+	obj, resourceIf, _, err := clients.resourceInterfaceFor(obj, namespace)
+	if err != nil {
+		return "", err
+	}
+	if validate {
+		if err = clients.validateObject(obj); err != nil {
+			return "", err
+		}
+	}
+	return applyObject(resourceIf, obj, dryRun, force)
+}
+
+// validateObject validates the object against the OpenAPI schema published by the API server
+func (c *clusterClients) validateObject(obj *unstructured.Unstructured) error {
+	resources, err := c.getOpenAPIResources()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return openapivalidation.NewSchemaValidation(resources).ValidateBytes(data)
+}
+
+// ReplaceResource replaces a unstructured resource, or creates it if it does not exist yet. If force
+// is true, the resource is deleted and re-created if the replace is rejected.
+func (k *InProcessKubectl) ReplaceResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+	log.Infof("Replacing resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
+	clients, err := k.getClients(config)
+	if err != nil {
+		return "", err
+	}
+	obj, resourceIf, _, err := clients.resourceInterfaceFor(obj, namespace)
+	if err != nil {
+		return "", err
+	}
+	return replaceObject(resourceIf, obj, dryRun, force)
+}
+
+// resourceInterfaceFor returns a copy of the object with the namespace set according to the scope
+// of its resource, along with the dynamic resource interface and API resource of the object
+func (c *clusterClients) resourceInterfaceFor(obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, dynamic.ResourceInterface, *metav1.APIResource, error) {
+	gvk := obj.GroupVersionKind()
+	apiResource, err := ServerResourceForGroupVersionKind(c.disco, gvk)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	obj = obj.DeepCopy()
+	if apiResource.Namespaced {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+	} else {
+		obj.SetNamespace("")
+	}
+	resource := gvk.GroupVersion().WithResource(apiResource.Name)
+	return obj, ToResourceInterface(c.dynamicIf, apiResource, resource, obj.GetNamespace()), apiResource, nil
+}
+
+// ServerSideApplyResource performs a server-side apply of a unstructured resource using the given
+// field manager. If force is true, conflicts with other field managers are overridden.
+func (k *InProcessKubectl) ServerSideApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, fieldManager string, dryRun, force bool) (string, error) {
+	log.Infof("Server-side applying resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
+	clients, err := k.getClients(config)
+	if err != nil {
+		return "", err
+	}
+	obj, _, apiResource, err := clients.resourceInterfaceFor(obj, namespace)
+	if err != nil {
+		return "", err
+	}
+	obj = withoutLastAppliedConfig(obj)
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	if fieldManager == "" {
+		fieldManager = common.ArgoCDFieldManager
+	}
+	req := clients.restClient.Patch(applyPatchType).
+		AbsPath(resourcePath(obj.GroupVersionKind(), apiResource, obj.GetNamespace(), obj.GetName())...).
+		Param("fieldManager", fieldManager).
+		Body(data)
+	if force {
+		req = req.Param("force", "true")
+	}
+	if dryRun {
+		req = req.Param("dryRun", "All")
+	}
+	if err = req.Do().Error(); err != nil {
+		return "", err
+	}
+	return applyOutput(obj, "serverside-applied", dryRun), nil
+}
+
+// withoutLastAppliedConfig returns a copy of the object without the last-applied-configuration
+// annotation, which is not used by server-side apply
+func withoutLastAppliedConfig(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	obj = obj.DeepCopy()
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[corev1.LastAppliedConfigAnnotation]; ok {
+		delete(annotations, corev1.LastAppliedConfigAnnotation)
+		obj.SetAnnotations(annotations)
+	}
+	return obj
+}
+
+// newRawRESTClient returns a REST client which is not bound to any API group
+func newRawRESTClient(config *rest.Config) (*rest.RESTClient, error) {
+	config = rest.CopyConfig(config)
+	config.GroupVersion = &schema.GroupVersion{}
+	config.APIPath = "/"
+	config.AcceptContentTypes = "application/json"
+	config.ContentType = "application/json"
+	config.NegotiatedSerializer = serializer.DirectCodecFactory{CodecFactory: scheme.Codecs}
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+	return rest.RESTClientFor(config)
+}
+
+// resourcePath returns the path segments of a resource in the Kubernetes API
+func resourcePath(gvk schema.GroupVersionKind, apiResource *metav1.APIResource, namespace, name string) []string {
+	var segments []string
+	if gvk.Group == "" {
+		segments = []string{"/api", gvk.Version}
+	} else {
+		segments = []string{"/apis", gvk.Group, gvk.Version}
+	}
+	if apiResource.Namespaced && namespace != "" {
+		segments = append(segments, "namespaces", namespace)
+	}
+	return append(segments, apiResource.Name, name)
+}
+
+// applyObject creates the object if it does not exist yet, or patches the live object otherwise.
+// Dry runs of new objects are performed client-side, since their namespace might not exist yet,
+// while patches of existing objects are dry-run by the API server.
+// Returns a message in the same format as kubectl (e.g. "deployment.apps/guestbook configured").
+func applyObject(resourceIf dynamic.ResourceInterface, obj *unstructured.Unstructured, dryRun, force bool) (string, error) {
+	modified, err := setLastAppliedConfigAnnotation(obj)
+	if err != nil {
+		return "", err
+	}
+	for i := 0; ; i++ {
+		live, err := resourceIf.Get(obj.GetName(), metav1.GetOptions{})
+		if err != nil {
+			if !apierr.IsNotFound(err) {
+				return "", err
+			}
+			if !dryRun {
+				if _, err = resourceIf.Create(obj, metav1.CreateOptions{}); err != nil {
+					return "", err
+				}
+			}
+			return applyOutput(obj, "created", dryRun), nil
+		}
+
+		// roleRef of RBAC bindings is immutable, so the binding has to be recreated if it changed.
+		// This is what `kubectl auth reconcile` would do.
+		// See: https://github.com/kubernetes/kubernetes/issues/66353
+		if isRoleRefChanged(live, obj) {
+			if !dryRun {
+				if err = recreateObject(resourceIf, obj); err != nil {
+					return "", err
+				}
+			}
+			return applyOutput(obj, "configured", dryRun), nil
+		}
+
+		patchType, patch, err := createApplyPatch(live, modified)
+		if err != nil {
+			return "", err
+		}
+		if string(patch) == "{}" {
+			return applyOutput(obj, "unchanged", dryRun), nil
+		}
+		updateOptions := metav1.UpdateOptions{}
+		if dryRun {
+			updateOptions.DryRun = []string{metav1.DryRunAll}
+		}
+		_, err = resourceIf.Patch(obj.GetName(), patchType, patch, updateOptions)
+		if err == nil {
+			return applyOutput(obj, "configured", dryRun), nil
+		}
+		if apierr.IsConflict(err) && i < maxPatchRetry {
+			continue
+		}
+		if force && !dryRun && (apierr.IsConflict(err) || apierr.IsInvalid(err)) {
+			if err = recreateObject(resourceIf, obj); err != nil {
+				return "", err
+			}
+			return applyOutput(obj, "configured", dryRun), nil
+		}
+		return "", err
+	}
+}
+
+// replaceObject replaces the live object with the supplied object, or creates it if it does not exist
+func replaceObject(resourceIf dynamic.ResourceInterface, obj *unstructured.Unstructured, dryRun, force bool) (string, error) {
+	// the annotation is kept up to date so that diffing works the same as for applied resources
+	if _, err := setLastAppliedConfigAnnotation(obj); err != nil {
+		return "", err
+	}
+	live, err := resourceIf.Get(obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return "", err
+		}
+		if !dryRun {
+			if _, err = resourceIf.Create(obj, metav1.CreateOptions{}); err != nil {
+				return "", err
+			}
+		}
+		return applyOutput(obj, "created", dryRun), nil
+	}
+	if dryRun {
+		return applyOutput(obj, "replaced", dryRun), nil
+	}
+	obj.SetResourceVersion(live.GetResourceVersion())
+	_, err = resourceIf.Update(obj, metav1.UpdateOptions{})
+	if err != nil {
+		if !force || !(apierr.IsConflict(err) || apierr.IsInvalid(err)) {
+			return "", err
+		}
+		obj.SetResourceVersion("")
+		if err = recreateObject(resourceIf, obj); err != nil {
+			return "", err
+		}
+	}
+	return applyOutput(obj, "replaced", dryRun), nil
+}
+
+// setLastAppliedConfigAnnotation stores the serialized object in its own
+// last-applied-configuration annotation and returns the serialized annotated object
+func setLastAppliedConfigAnnotation(obj *unstructured.Unstructured) ([]byte, error) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	delete(annotations, corev1.LastAppliedConfigAnnotation)
+	if len(annotations) == 0 {
+		obj.SetAnnotations(nil)
+	} else {
+		obj.SetAnnotations(annotations)
+	}
+	original, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	annotations[corev1.LastAppliedConfigAnnotation] = string(original)
+	obj.SetAnnotations(annotations)
+	return json.Marshal(obj)
+}
+
+// createApplyPatch calculates a three-way patch between the last-applied-configuration of the
+// live object, the modified configuration and the live object. Strategic merge patches are used
+// for kinds known to kubectl, and JSON merge patches for everything else (e.g. custom resources).
+func createApplyPatch(live *unstructured.Unstructured, modified []byte) (types.PatchType, []byte, error) {
+	var original []byte
+	if lastApplied, ok := live.GetAnnotations()[corev1.LastAppliedConfigAnnotation]; ok {
+		original = []byte(lastApplied)
+	}
+	current, err := json.Marshal(live)
+	if err != nil {
+		return "", nil, err
+	}
+	versionedObject, err := scheme.Scheme.New(live.GroupVersionKind())
+	switch {
+	case runtime.IsNotRegisteredError(err):
+		patch, err := createThreeWayJSONMergePatch(original, modified, current)
+		if err != nil {
+			return "", nil, err
+		}
+		return types.MergePatchType, patch, nil
+	case err != nil:
+		return "", nil, err
+	}
+	lookupPatchMeta, err := strategicpatch.NewPatchMetaFromStruct(versionedObject)
+	if err != nil {
+		return "", nil, err
+	}
+	patch, err := strategicpatch.CreateThreeWayMergePatch(original, modified, current, lookupPatchMeta, true)
+	if err != nil {
+		return "", nil, err
+	}
+	return types.StrategicMergePatchType, patch, nil
+}
+
+// createThreeWayJSONMergePatch creates a JSON merge patch which deletes the fields removed from
+// the original configuration and sets the fields which differ between modified and current.
+// Fields which are only present in the current object (e.g. defaulted by the API server) are kept.
+func createThreeWayJSONMergePatch(original, modified, current []byte) ([]byte, error) {
+	if len(original) == 0 {
+		original = []byte("{}")
+	}
+	deletions, err := jsonpatch.CreateMergePatch(original, modified)
+	if err != nil {
+		return nil, err
+	}
+	deletions, err = filterNullFields(deletions, true)
+	if err != nil {
+		return nil, err
+	}
+	delta, err := jsonpatch.CreateMergePatch(current, modified)
+	if err != nil {
+		return nil, err
+	}
+	delta, err = filterNullFields(delta, false)
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.MergeMergePatches(deletions, delta)
+}
+
+// filterNullFields keeps only the fields of a merge patch which are set to null if keepNull is
+// true, or only the fields which are not set to null otherwise
+func filterNullFields(patch []byte, keepNull bool) ([]byte, error) {
+	var patchMap map[string]interface{}
+	if err := json.Unmarshal(patch, &patchMap); err != nil {
+		return nil, err
+	}
+	return json.Marshal(filterNullFieldsInMap(patchMap, keepNull))
+}
+
+func filterNullFieldsInMap(patchMap map[string]interface{}, keepNull bool) map[string]interface{} {
+	filtered := make(map[string]interface{})
+	for key, val := range patchMap {
+		switch typedVal := val.(type) {
+		case nil:
+			if keepNull {
+				filtered[key] = nil
+			}
+		case map[string]interface{}:
+			if len(typedVal) == 0 {
+				if !keepNull {
+					filtered[key] = typedVal
+				}
+				continue
+			}
+			if nested := filterNullFieldsInMap(typedVal, keepNull); len(nested) > 0 {
+				filtered[key] = nested
+			}
+		default:
+			if !keepNull {
+				filtered[key] = val
+			}
+		}
+	}
+	return filtered
+}
+
+// isRoleRefChanged returns whether or not the immutable roleRef of an RBAC binding was changed
+func isRoleRefChanged(live, obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	if gvk.Group != "rbac.authorization.k8s.io" || (gvk.Kind != "RoleBinding" && gvk.Kind != "ClusterRoleBinding") {
+		return false
+	}
+	liveRoleRef, _, _ := unstructured.NestedMap(live.Object, "roleRef")
+	roleRef, _, _ := unstructured.NestedMap(obj.Object, "roleRef")
+	return !reflect.DeepEqual(liveRoleRef, roleRef)
+}
+
+// recreateObject deletes the live object, waits until it is gone and creates it again
+func recreateObject(resourceIf dynamic.ResourceInterface, obj *unstructured.Unstructured) error {
+	propagationPolicy := metav1.DeletePropagationBackground
+	err := resourceIf.Delete(obj.GetName(), &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+	if err != nil && !apierr.IsNotFound(err) {
+		return err
+	}
+	err = wait.PollImmediate(time.Second, recreateTimeout, func() (bool, error) {
+		_, err := resourceIf.Get(obj.GetName(), metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to wait for deletion of %s/%s: %v", obj.GetKind(), obj.GetName(), err)
+	}
+	_, err = resourceIf.Create(obj, metav1.CreateOptions{})
+	return err
+}
+
+// applyOutput formats the result of an apply the same way as kubectl
+func applyOutput(obj *unstructured.Unstructured, result string, dryRun bool) string {
+	resourceName := strings.ToLower(obj.GetKind())
+	if group := obj.GroupVersionKind().Group; group != "" {
+		resourceName = fmt.Sprintf("%s.%s", resourceName, group)
+	}
+	out := fmt.Sprintf("%s/%s %s", resourceName, obj.GetName(), result)
+	if dryRun {
+		out += " (dry run)"
+	}
+	return out
+}
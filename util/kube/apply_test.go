@@ -0,0 +1,90 @@
+package kube
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/rest"
+)
+
+func TestApplyObject(t *testing.T) {
+	yamlBytes, err := ioutil.ReadFile("testdata/svc.yaml")
+	assert.Nil(t, err)
+	var obj unstructured.Unstructured
+	err = yaml.Unmarshal(yamlBytes, &obj)
+	assert.Nil(t, err)
+	obj.SetNamespace("default")
+
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	resourceIf := client.Resource(schema.GroupVersionResource{Version: "v1", Resource: "services"}).Namespace("default")
+
+	out, err := applyObject(resourceIf, obj.DeepCopy(), true, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "service/my-service created (dry run)", out)
+	_, err = resourceIf.Get("my-service", metav1.GetOptions{})
+	assert.Error(t, err)
+
+	out, err = applyObject(resourceIf, obj.DeepCopy(), false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "service/my-service created", out)
+	live, err := resourceIf.Get("my-service", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Contains(t, live.GetAnnotations(), corev1.LastAppliedConfigAnnotation)
+
+	out, err = applyObject(resourceIf, obj.DeepCopy(), false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "service/my-service unchanged", out)
+
+	obj.SetLabels(map[string]string{"foo": "bar"})
+	out, err = applyObject(resourceIf, obj.DeepCopy(), true, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "service/my-service configured (dry run)", out)
+}
+
+func TestCreateThreeWayJSONMergePatch(t *testing.T) {
+	original := []byte(`{"spec":{"replicas":1,"removed":"a"}}`)
+	modified := []byte(`{"spec":{"replicas":2}}`)
+	current := []byte(`{"spec":{"replicas":1,"removed":"a","defaulted":"b"}}`)
+
+	patch, err := createThreeWayJSONMergePatch(original, modified, current)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"spec":{"replicas":2,"removed":null}}`, string(patch))
+
+	patch, err = createThreeWayJSONMergePatch(modified, modified, []byte(`{"spec":{"replicas":2,"defaulted":"b"}}`))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{}`, string(patch))
+}
+
+func TestWithoutLastAppliedConfig(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAnnotations(map[string]string{corev1.LastAppliedConfigAnnotation: "{}", "foo": "bar"})
+
+	res := withoutLastAppliedConfig(obj)
+	assert.Equal(t, map[string]string{"foo": "bar"}, res.GetAnnotations())
+	// the supplied object is not modified
+	assert.Contains(t, obj.GetAnnotations(), corev1.LastAppliedConfigAnnotation)
+}
+
+func TestInProcessKubectlReusesClients(t *testing.T) {
+	kubectl := NewInProcessKubectl()
+	clients, err := kubectl.getClients(&rest.Config{Host: "https://localhost:6443", BearerToken: "token"})
+	assert.NoError(t, err)
+
+	// the clients are reused for the same cluster config
+	sameClients, err := kubectl.getClients(&rest.Config{Host: "https://localhost:6443", BearerToken: "token"})
+	assert.NoError(t, err)
+	assert.True(t, clients == sameClients)
+
+	// but created again once the config of the cluster changes
+	newClients, err := kubectl.getClients(&rest.Config{Host: "https://localhost:6443", BearerToken: "new-token"})
+	assert.NoError(t, err)
+	assert.False(t, clients == newClients)
+}
@@ -20,6 +20,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/diff"
 )
@@ -29,12 +30,15 @@ var (
 )
 
 type Kubectl interface {
-	ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error)
+	ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error)
+	ReplaceResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error)
+	ServerSideApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, fieldManager string, dryRun, force bool) (string, error)
 	ConvertToVersion(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error)
-	DeleteResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, forceDelete bool) error
+	DeleteResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, deleteOptions metav1.DeleteOptions) error
 	GetResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error)
 	PatchResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte) (*unstructured.Unstructured, error)
 	GetAPIResources(config *rest.Config, resourceFilter ResourceFilter) ([]APIResourceInfo, error)
+	GetServerVersion(config *rest.Config) (string, error)
 }
 
 type KubectlCmd struct{}
@@ -42,12 +46,12 @@ type KubectlCmd struct{}
 type APIResourceInfo struct {
 	GroupKind schema.GroupKind
 	Meta      metav1.APIResource
-	Interface dynamic.ResourceInterface
+	Interface dynamic.NamespaceableResourceInterface
 }
 
 type filterFunc func(apiResource *metav1.APIResource) bool
 
-func filterAPIResources(config *rest.Config, resourceFilter ResourceFilter, filter filterFunc, namespace string) ([]APIResourceInfo, error) {
+func filterAPIResources(config *rest.Config, resourceFilter ResourceFilter, filter filterFunc) ([]APIResourceInfo, error) {
 	dynamicIf, err := dynamic.NewForConfig(config)
 	if err != nil {
 		return nil, err
@@ -90,7 +94,7 @@ func filterAPIResources(config *rest.Config, resourceFilter ResourceFilter, filt
 			}
 			if filter(&apiResource) {
 				resource := ToGroupVersionResource(apiResourcesList.GroupVersion, &apiResource)
-				resourceIf := ToResourceInterface(dynamicIf, &apiResource, resource, namespace)
+				resourceIf := dynamicIf.Resource(resource)
 				gv, err := schema.ParseGroupVersion(apiResourcesList.GroupVersion)
 				if err != nil {
 					return nil, err
@@ -120,13 +124,26 @@ func isSupportedVerb(apiResource *metav1.APIResource, verb string) bool {
 func (k KubectlCmd) GetAPIResources(config *rest.Config, resourceFilter ResourceFilter) ([]APIResourceInfo, error) {
 	apiResIfs, err := filterAPIResources(config, resourceFilter, func(apiResource *metav1.APIResource) bool {
 		return isSupportedVerb(apiResource, listVerb) && isSupportedVerb(apiResource, watchVerb)
-	}, "")
+	})
 	if err != nil {
 		return nil, err
 	}
 	return apiResIfs, err
 }
 
+// GetServerVersion returns the Kubernetes version of the cluster
+func (k KubectlCmd) GetServerVersion(config *rest.Config) (string, error) {
+	disco, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return "", err
+	}
+	versionInfo, err := disco.ServerVersion()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s.%s", versionInfo.Major, versionInfo.Minor), nil
+}
+
 // GetResource returns resource
 func (k KubectlCmd) GetResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
 	dynamicIf, err := dynamic.NewForConfig(config)
@@ -166,7 +183,7 @@ func (k KubectlCmd) PatchResource(config *rest.Config, gvk schema.GroupVersionKi
 }
 
 // DeleteResource deletes resource
-func (k KubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, forceDelete bool) error {
+func (k KubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, deleteOptions metav1.DeleteOptions) error {
 	dynamicIf, err := dynamic.NewForConfig(config)
 	if err != nil {
 		return err
@@ -181,30 +198,22 @@ func (k KubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVersionK
 	}
 	resource := gvk.GroupVersion().WithResource(apiResource.Name)
 	resourceIf := ToResourceInterface(dynamicIf, apiResource, resource, namespace)
-	propagationPolicy := metav1.DeletePropagationForeground
-	deleteOptions := &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}
-	if forceDelete {
-		propagationPolicy = metav1.DeletePropagationBackground
-		zeroGracePeriod := int64(0)
-		deleteOptions.GracePeriodSeconds = &zeroGracePeriod
-	}
+	return resourceIf.Delete(name, &deleteOptions)
+}
 
-	return resourceIf.Delete(name, deleteOptions)
+// NewDeleteOptions returns the options to delete a resource using the supplied propagation policy
+func NewDeleteOptions(propagationPolicy metav1.DeletionPropagation) metav1.DeleteOptions {
+	return metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}
 }
 
 // ApplyResource performs an apply of a unstructured resource
-func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error) {
 	log.Infof("Applying resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
-	f, err := ioutil.TempFile(util.TempDir, "")
+	kubeconfigPath, err := writeTempKubeConfig(config, namespace)
 	if err != nil {
-		return "", fmt.Errorf("Failed to generate temp file for kubeconfig: %v", err)
-	}
-	_ = f.Close()
-	err = WriteKubeConfig(config, namespace, f.Name())
-	if err != nil {
-		return "", fmt.Errorf("Failed to write kubeconfig: %v", err)
+		return "", err
 	}
-	defer util.DeleteFile(f.Name())
+	defer util.DeleteFile(kubeconfigPath)
 	manifestBytes, err := json.Marshal(obj)
 	if err != nil {
 		return "", err
@@ -229,7 +238,7 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 				return "", err
 			}
 		}
-		outReconcile, err := runKubectl(f.Name(), namespace, []string{"auth", "reconcile"}, manifestBytes, dryRun)
+		outReconcile, err := runKubectl(kubeconfigPath, namespace, []string{"auth", "reconcile"}, manifestBytes, dryRun)
 		if err != nil {
 			return "", err
 		}
@@ -243,7 +252,10 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 	if force {
 		applyArgs = append(applyArgs, "--force")
 	}
-	outApply, err := runKubectl(f.Name(), namespace, applyArgs, manifestBytes, dryRun)
+	if !validate {
+		applyArgs = append(applyArgs, "--validate=false")
+	}
+	outApply, err := runKubectl(kubeconfigPath, namespace, applyArgs, manifestBytes, dryRun)
 	if err != nil {
 		return "", err
 	}
@@ -251,6 +263,81 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 	return strings.Join(out, ". "), nil
 }
 
+// ReplaceResource replaces a unstructured resource using `kubectl replace`, or creates it if it does
+// not exist yet. If force is true, the resource is deleted and re-created if the replace is rejected.
+func (k KubectlCmd) ReplaceResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+	log.Infof("Replacing resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
+	kubeconfigPath, err := writeTempKubeConfig(config, namespace)
+	if err != nil {
+		return "", err
+	}
+	defer util.DeleteFile(kubeconfigPath)
+	manifestBytes, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	if dryRun {
+		// `kubectl replace` does not support dry runs, so the manifest is only validated
+		return runKubectl(kubeconfigPath, namespace, []string{"create", "--save-config"}, manifestBytes, true)
+	}
+	// --save-config keeps the last-applied-configuration annotation up to date, so that diffing
+	// works the same as for applied resources
+	out, err := runKubectl(kubeconfigPath, namespace, []string{"replace", "--save-config"}, manifestBytes, false)
+	switch {
+	case err == nil:
+		return out, nil
+	case strings.Contains(err.Error(), "(NotFound)"):
+		return runKubectl(kubeconfigPath, namespace, []string{"create", "--save-config"}, manifestBytes, false)
+	case force:
+		return runKubectl(kubeconfigPath, namespace, []string{"replace", "--save-config", "--force"}, manifestBytes, false)
+	default:
+		return "", err
+	}
+}
+
+// ServerSideApplyResource performs a server-side apply of a unstructured resource using
+// `kubectl apply --server-side` with the given field manager. If force is true, conflicts with other
+// field managers are overridden.
+func (k KubectlCmd) ServerSideApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, fieldManager string, dryRun, force bool) (string, error) {
+	log.Infof("Server-side applying resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
+	kubeconfigPath, err := writeTempKubeConfig(config, namespace)
+	if err != nil {
+		return "", err
+	}
+	defer util.DeleteFile(kubeconfigPath)
+	manifestBytes, err := json.Marshal(withoutLastAppliedConfig(obj))
+	if err != nil {
+		return "", err
+	}
+	if fieldManager == "" {
+		fieldManager = common.ArgoCDFieldManager
+	}
+	applyArgs := []string{"apply", "--server-side", "--field-manager", fieldManager}
+	if force {
+		applyArgs = append(applyArgs, "--force-conflicts")
+	}
+	if dryRun {
+		applyArgs = append(applyArgs, "--server-dry-run")
+	}
+	return runKubectl(kubeconfigPath, namespace, applyArgs, manifestBytes, false)
+}
+
+// writeTempKubeConfig writes the kubeconfig of the given REST config to a temporary file and returns
+// the path of the file. The caller is responsible for deleting the file.
+func writeTempKubeConfig(config *rest.Config, namespace string) (string, error) {
+	f, err := ioutil.TempFile(util.TempDir, "")
+	if err != nil {
+		return "", fmt.Errorf("Failed to generate temp file for kubeconfig: %v", err)
+	}
+	_ = f.Close()
+	err = WriteKubeConfig(config, namespace, f.Name())
+	if err != nil {
+		util.DeleteFile(f.Name())
+		return "", fmt.Errorf("Failed to write kubeconfig: %v", err)
+	}
+	return f.Name(), nil
+}
+
 func runKubectl(kubeconfigPath string, namespace string, args []string, manifestBytes []byte, dryRun bool) (string, error) {
 	cmdArgs := append([]string{"--kubeconfig", kubeconfigPath, "-f", "-"}, args...)
 	if namespace != "" {
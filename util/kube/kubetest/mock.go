@@ -1,6 +1,7 @@
 package kubetest
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -19,12 +20,18 @@ type MockKubectlCmd struct {
 	APIResources []kube.APIResourceInfo
 	Commands     map[string]KubectlOutput
 	Events       chan watch.Event
+	Version      string
+	VersionError error
 }
 
 func (k MockKubectlCmd) GetAPIResources(config *rest.Config, resourceFilter kube.ResourceFilter) ([]kube.APIResourceInfo, error) {
 	return k.APIResources, nil
 }
 
+func (k MockKubectlCmd) GetServerVersion(config *rest.Config) (string, error) {
+	return k.Version, k.VersionError
+}
+
 func (k MockKubectlCmd) GetResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
 	return nil, nil
 }
@@ -33,7 +40,7 @@ func (k MockKubectlCmd) PatchResource(config *rest.Config, gvk schema.GroupVersi
 	return nil, nil
 }
 
-func (k MockKubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, forceDelete bool) error {
+func (k MockKubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, deleteOptions metav1.DeleteOptions) error {
 	command, ok := k.Commands[name]
 	if !ok {
 		return nil
@@ -41,7 +48,7 @@ func (k MockKubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVers
 	return command.Err
 }
 
-func (k MockKubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+func (k MockKubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error) {
 	command, ok := k.Commands[obj.GetName()]
 	if !ok {
 		return "", nil
@@ -49,6 +56,14 @@ func (k MockKubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Uns
 	return command.Output, command.Err
 }
 
+func (k MockKubectlCmd) ServerSideApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, fieldManager string, dryRun, force bool) (string, error) {
+	return k.ApplyResource(config, obj, namespace, dryRun, force, true)
+}
+
+func (k MockKubectlCmd) ReplaceResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+	return k.ApplyResource(config, obj, namespace, dryRun, force, true)
+}
+
 // ConvertToVersion converts an unstructured object into the specified group/version
 func (k MockKubectlCmd) ConvertToVersion(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error) {
 	return obj, nil
@@ -159,3 +159,28 @@ func TestValidPolicyRules(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+// TestValidateSyncWindows checks validation of project sync windows
+func TestValidateSyncWindows(t *testing.T) {
+	p := newTestProject()
+	p.Spec.SyncWindows = []*argoappv1.SyncWindow{{Kind: "allow", Schedule: "0 22 * * *", Duration: "1h", Applications: []string{"*"}}}
+	assert.NoError(t, ValidateProject(p))
+
+	badWindows := []struct {
+		window argoappv1.SyncWindow
+		errmsg string
+	}{
+		{argoappv1.SyncWindow{Kind: "maybe", Schedule: "0 22 * * *", Duration: "1h"}, "kind 'maybe' is invalid"},
+		{argoappv1.SyncWindow{Kind: "deny", Schedule: "0 22 * *", Duration: "1h"}, "schedule is invalid"},
+		{argoappv1.SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "1y"}, "duration '1y' is invalid"},
+		{argoappv1.SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "-1h"}, "must be positive"},
+	}
+	for _, bad := range badWindows {
+		window := bad.window
+		p.Spec.SyncWindows = []*argoappv1.SyncWindow{&window}
+		err := ValidateProject(p)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), bad.errmsg)
+		}
+	}
+}
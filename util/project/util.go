@@ -86,6 +86,14 @@ func ValidateProject(p *v1alpha1.AppProject) error {
 	if err := validatePolicySyntax(p); err != nil {
 		return err
 	}
+	for i, window := range p.Spec.SyncWindows {
+		if window == nil {
+			return status.Errorf(codes.InvalidArgument, "sync window %d is empty", i)
+		}
+		if err := window.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "sync window %d is invalid: %v", i, err)
+		}
+	}
 	return nil
 }
 
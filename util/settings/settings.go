@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -71,6 +72,11 @@ type ArgoCDSettings struct {
 	ResourceOverrides map[string]v1alpha1.ResourceOverride
 	// ResourceExclusions holds the api groups, kinds per cluster to exclude from Argo CD's watch
 	ResourceExclusions []ExcludedResource
+	// OperationTimeout is the default maximum amount of time an operation may run before it is terminated.
+	// Zero means operations never time out
+	OperationTimeout time.Duration
+	// AutoSyncDisabled suspends automated syncs of all applications
+	AutoSyncDisabled bool
 }
 
 type OIDCConfig struct {
@@ -134,6 +140,10 @@ const (
 	resourceExclusionsKey = "resource.exclusions"
 	// configManagementPluginsKey is the key to the list of config management plugins
 	configManagementPluginsKey = "configManagementPlugins"
+	// operationTimeoutKey is the key to the default timeout of application operations
+	operationTimeoutKey = "operation.timeout"
+	// autoSyncDisabledKey is the key to suspend automated syncs of all applications
+	autoSyncDisabledKey = "autosync.disabled"
 )
 
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
@@ -373,6 +383,24 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *apiv1.Confi
 		}
 	}
 
+	if value, ok := argoCDCM.Data[operationTimeoutKey]; ok && value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("invalid %s: %v", operationTimeoutKey, err))
+		} else {
+			settings.OperationTimeout = timeout
+		}
+	}
+
+	if value, ok := argoCDCM.Data[autoSyncDisabledKey]; ok && value != "" {
+		disabled, err := strconv.ParseBool(value)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("invalid %s: %v", autoSyncDisabledKey, err))
+		} else {
+			settings.AutoSyncDisabled = disabled
+		}
+	}
+
 	if len(errors) > 0 {
 		return errors[0]
 	}
@@ -505,6 +533,18 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 		delete(argoCDCM.Data, resourceExclusionsKey)
 	}
 
+	if settings.OperationTimeout > 0 {
+		argoCDCM.Data[operationTimeoutKey] = settings.OperationTimeout.String()
+	} else {
+		delete(argoCDCM.Data, operationTimeoutKey)
+	}
+
+	if settings.AutoSyncDisabled {
+		argoCDCM.Data[autoSyncDisabledKey] = "true"
+	} else {
+		delete(argoCDCM.Data, autoSyncDisabledKey)
+	}
+
 	if createCM {
 		_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Create(argoCDCM)
 	} else {
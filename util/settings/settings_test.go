@@ -2,6 +2,7 @@ package settings
 
 import (
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 
@@ -33,3 +34,37 @@ func TestUpdateSettingsFromConfigMapResourceExclusions(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, []ExcludedResource{{APIGroups: []string{}, Kinds: []string{}, Clusters: []string{}}}, settings.ResourceExclusions)
 }
+
+func TestUpdateSettingsFromConfigMapOperationTimeout(t *testing.T) {
+	settings := ArgoCDSettings{}
+	configMap := v1.ConfigMap{}
+	err := updateSettingsFromConfigMap(&settings, &configMap)
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), settings.OperationTimeout)
+
+	configMap.Data = map[string]string{"operation.timeout": "1h"}
+	err = updateSettingsFromConfigMap(&settings, &configMap)
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, settings.OperationTimeout)
+
+	configMap.Data = map[string]string{"operation.timeout": "soon"}
+	err = updateSettingsFromConfigMap(&settings, &configMap)
+
+	assert.Error(t, err)
+}
+
+func TestUpdateSettingsFromConfigMapAutoSyncDisabled(t *testing.T) {
+	settings := ArgoCDSettings{}
+	configMap := v1.ConfigMap{Data: map[string]string{"autosync.disabled": "true"}}
+	err := updateSettingsFromConfigMap(&settings, &configMap)
+
+	assert.NoError(t, err)
+	assert.True(t, settings.AutoSyncDisabled)
+
+	configMap.Data = map[string]string{"autosync.disabled": "maybe"}
+	err = updateSettingsFromConfigMap(&ArgoCDSettings{}, &configMap)
+
+	assert.Error(t, err)
+}